@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	clientSideFilterMatchExact    = "exact"
+	clientSideFilterMatchWildcard = "wildcard"
+	clientSideFilterMatchRegex    = "regex"
+)
+
+// clientSideFiltersSchema returns a *schema.Schema that represents a set of
+// filtering criteria evaluated by the provider itself, rather than by the
+// API, against each object returned by a "List..." or "Describe..." call.
+//
+// It is the counterpart of ec2CustomFiltersSchema for services whose APIs
+// have no server-side filtering. It is conventional for an attribute of this
+// type to be included as a top-level attribute called "filter". Each object
+// is first flattened into a map, whose keys are the filter names, and an
+// object is only kept if it matches all filters. In Terraform configuration
+// the filter blocks then look like this:
+//
+//	filter {
+//	  name   = "engine"
+//	  values = ["aurora*"]
+//	  match  = "wildcard"
+//	}
+//
+// A filter matches when the value at the given attribute path equals any of
+// the given values. Nested attributes are addressed with dots, e.g.
+// "vpc_config.vpc_id", and list attributes match when any element matches.
+func clientSideFiltersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"match": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  clientSideFilterMatchExact,
+					ValidateFunc: validation.StringInSlice([]string{
+						clientSideFilterMatchExact,
+						clientSideFilterMatchWildcard,
+						clientSideFilterMatchRegex,
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+// clientSideFilter is a single compiled filter block.
+type clientSideFilter struct {
+	path     []string
+	patterns []*regexp.Regexp
+}
+
+// buildClientSideFilterList takes the set value extracted from a schema
+// attribute conforming to the schema returned by clientSideFiltersSchema
+// and compiles it into filters ready to be evaluated by
+// clientSideFiltersMatch.
+func buildClientSideFilterList(filterSet *schema.Set) ([]*clientSideFilter, error) {
+	if filterSet == nil {
+		return nil, nil
+	}
+
+	filters := make([]*clientSideFilter, 0, filterSet.Len())
+
+	for _, filterI := range filterSet.List() {
+		m := filterI.(map[string]interface{})
+		name := m["name"].(string)
+		match := m["match"].(string)
+
+		filter := &clientSideFilter{
+			path: strings.Split(name, "."),
+		}
+
+		for _, valueI := range m["values"].(*schema.Set).List() {
+			var expr string
+
+			switch value := valueI.(string); match {
+			case clientSideFilterMatchRegex:
+				expr = value
+			case clientSideFilterMatchWildcard:
+				expr = regexp.QuoteMeta(value)
+				expr = strings.Replace(expr, `\*`, ".*", -1)
+				expr = strings.Replace(expr, `\?`, ".", -1)
+			default:
+				expr = regexp.QuoteMeta(value)
+			}
+
+			pattern, err := regexp.Compile("^(?:" + expr + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("error compiling filter %q value %q: %s", name, valueI.(string), err)
+			}
+
+			filter.patterns = append(filter.patterns, pattern)
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+// clientSideFiltersMatch returns whether the flattened object matches all of
+// the given filters.
+func clientSideFiltersMatch(filters []*clientSideFilter, object map[string]interface{}) bool {
+	for _, filter := range filters {
+		if !filter.matches(object) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// clientSideFiltersReference returns whether any of the given filters refers
+// to the given top-level attribute, e.g. to avoid fetching tags unless needed.
+func clientSideFiltersReference(filters []*clientSideFilter, attr string) bool {
+	for _, filter := range filters {
+		if filter.path[0] == attr {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *clientSideFilter) matches(object map[string]interface{}) bool {
+	for _, value := range clientSideFilterValues(object, f.path) {
+		for _, pattern := range f.patterns {
+			if pattern.MatchString(value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// clientSideFilterValues returns the string representations of all scalar
+// values found at the given path, descending into lists and maps.
+func clientSideFilterValues(v interface{}, path []string) []string {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case map[string]interface{}:
+		if len(path) == 0 {
+			return nil
+		}
+		return clientSideFilterValues(v[path[0]], path[1:])
+	case map[string]string:
+		if len(path) != 1 {
+			return nil
+		}
+		if value, ok := v[path[0]]; ok {
+			return []string{value}
+		}
+		return nil
+	case []interface{}:
+		// An index selects a single element, otherwise all elements are
+		// searched.
+		if len(path) > 0 {
+			if i, err := strconv.Atoi(path[0]); err == nil {
+				if i < 0 || i >= len(v) {
+					return nil
+				}
+				return clientSideFilterValues(v[i], path[1:])
+			}
+		}
+		var values []string
+		for _, e := range v {
+			values = append(values, clientSideFilterValues(e, path)...)
+		}
+		return values
+	case []string:
+		l := make([]interface{}, len(v))
+		for i, e := range v {
+			l[i] = e
+		}
+		return clientSideFilterValues(l, path)
+	default:
+		if len(path) != 0 {
+			return nil
+		}
+		return []string{fmt.Sprintf("%v", v)}
+	}
+}
+
+// clientSideTagsMatch returns whether the given tags contain all of the
+// wanted tags. A wanted value of "*" matches any value of the tag.
+func clientSideTagsMatch(want map[string]interface{}, tags map[string]string) bool {
+	for k, v := range want {
+		value, ok := tags[k]
+		if !ok {
+			return false
+		}
+		if v.(string) != "*" && v.(string) != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestClientSideFiltersMatch(t *testing.T) {
+	object := map[string]interface{}{
+		"engine":              "aurora-postgresql",
+		"multi_az":            true,
+		"allocated_storage":   int64(20),
+		"security_group_ids":  []string{"sg-11111111", "sg-22222222"},
+		"availability_zones":  []interface{}{"us-west-2a", "us-west-2b"},
+		"tags":                map[string]string{"Name": "example"},
+		"vpc_config":          map[string]interface{}{"vpc_id": "vpc-12345678"},
+		"subnets":             []interface{}{map[string]interface{}{"id": "subnet-1"}, map[string]interface{}{"id": "subnet-2"}},
+		"endpoint":            nil,
+		"description_missing": "",
+	}
+
+	cases := []struct {
+		Name     string
+		Filters  []interface{}
+		Expected bool
+	}{
+		{
+			Name:     "no filters",
+			Expected: true,
+		},
+		{
+			Name:     "exact",
+			Filters:  []interface{}{clientSideFilterRaw("engine", clientSideFilterMatchExact, "mysql", "aurora-postgresql")},
+			Expected: true,
+		},
+		{
+			Name:     "exact mismatch",
+			Filters:  []interface{}{clientSideFilterRaw("engine", clientSideFilterMatchExact, "aurora")},
+			Expected: false,
+		},
+		{
+			Name:     "wildcard",
+			Filters:  []interface{}{clientSideFilterRaw("engine", clientSideFilterMatchWildcard, "aurora*")},
+			Expected: true,
+		},
+		{
+			Name:     "wildcard single character",
+			Filters:  []interface{}{clientSideFilterRaw("availability_zones", clientSideFilterMatchWildcard, "us-west-2?")},
+			Expected: true,
+		},
+		{
+			Name:     "wildcard quotes regex",
+			Filters:  []interface{}{clientSideFilterRaw("engine", clientSideFilterMatchWildcard, "aurora.postgresql")},
+			Expected: false,
+		},
+		{
+			Name:     "regex",
+			Filters:  []interface{}{clientSideFilterRaw("engine", clientSideFilterMatchRegex, "aurora(-mysql|-postgresql)?")},
+			Expected: true,
+		},
+		{
+			Name:     "bool",
+			Filters:  []interface{}{clientSideFilterRaw("multi_az", clientSideFilterMatchExact, "true")},
+			Expected: true,
+		},
+		{
+			Name:     "int",
+			Filters:  []interface{}{clientSideFilterRaw("allocated_storage", clientSideFilterMatchExact, "20")},
+			Expected: true,
+		},
+		{
+			Name:     "list any element",
+			Filters:  []interface{}{clientSideFilterRaw("security_group_ids", clientSideFilterMatchExact, "sg-22222222")},
+			Expected: true,
+		},
+		{
+			Name:     "list index",
+			Filters:  []interface{}{clientSideFilterRaw("security_group_ids.0", clientSideFilterMatchExact, "sg-22222222")},
+			Expected: false,
+		},
+		{
+			Name:     "nested map",
+			Filters:  []interface{}{clientSideFilterRaw("vpc_config.vpc_id", clientSideFilterMatchExact, "vpc-12345678")},
+			Expected: true,
+		},
+		{
+			Name:     "nested list of maps",
+			Filters:  []interface{}{clientSideFilterRaw("subnets.id", clientSideFilterMatchExact, "subnet-2")},
+			Expected: true,
+		},
+		{
+			Name:     "tags",
+			Filters:  []interface{}{clientSideFilterRaw("tags.Name", clientSideFilterMatchExact, "example")},
+			Expected: true,
+		},
+		{
+			Name:     "missing attribute",
+			Filters:  []interface{}{clientSideFilterRaw("missing", clientSideFilterMatchWildcard, "*")},
+			Expected: false,
+		},
+		{
+			Name:     "nil attribute",
+			Filters:  []interface{}{clientSideFilterRaw("endpoint", clientSideFilterMatchWildcard, "*")},
+			Expected: false,
+		},
+		{
+			Name: "all filters must match",
+			Filters: []interface{}{
+				clientSideFilterRaw("engine", clientSideFilterMatchWildcard, "aurora*"),
+				clientSideFilterRaw("multi_az", clientSideFilterMatchExact, "false"),
+			},
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		filters, err := buildClientSideFilterList(schema.NewSet(schema.HashResource(clientSideFiltersSchema().Elem.(*schema.Resource)), tc.Filters))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Name, err)
+		}
+
+		if actual := clientSideFiltersMatch(filters, object); actual != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", tc.Name, tc.Expected, actual)
+		}
+	}
+}
+
+func TestBuildClientSideFilterList_invalidRegex(t *testing.T) {
+	filterSet := schema.NewSet(schema.HashResource(clientSideFiltersSchema().Elem.(*schema.Resource)), []interface{}{
+		clientSideFilterRaw("engine", clientSideFilterMatchRegex, "aurora("),
+	})
+
+	if _, err := buildClientSideFilterList(filterSet); err == nil {
+		t.Fatal("expected error for invalid regular expression")
+	}
+}
+
+func TestClientSideTagsMatch(t *testing.T) {
+	tags := map[string]string{
+		"Name":        "example",
+		"Environment": "production",
+	}
+
+	cases := []struct {
+		Want     map[string]interface{}
+		Expected bool
+	}{
+		{map[string]interface{}{}, true},
+		{map[string]interface{}{"Name": "example"}, true},
+		{map[string]interface{}{"Name": "example", "Environment": "production"}, true},
+		{map[string]interface{}{"Name": "example", "Environment": "staging"}, false},
+		{map[string]interface{}{"Environment": "*"}, true},
+		{map[string]interface{}{"Owner": "*"}, false},
+	}
+
+	for _, tc := range cases {
+		if actual := clientSideTagsMatch(tc.Want, tags); actual != tc.Expected {
+			t.Errorf("%v: expected %t, got %t", tc.Want, tc.Expected, actual)
+		}
+	}
+}
+
+func clientSideFilterRaw(name, match string, values ...string) map[string]interface{} {
+	valuesI := make([]interface{}, len(values))
+	for i, v := range values {
+		valuesI[i] = v
+	}
+
+	return map[string]interface{}{
+		"name":   name,
+		"match":  match,
+		"values": schema.NewSet(schema.HashString, valuesI),
+	}
+}
@@ -198,6 +198,7 @@ type AWSClient struct {
 	accountid             string
 	supportedplatforms    []string
 	region                string
+	ruleOwnership         *ruleOwnership
 	rdsconn               *rds.RDS
 	iamconn               *iam.IAM
 	kinesisconn           *kinesis.Kinesis
@@ -272,6 +273,8 @@ func (c *Config) Client() (interface{}, error) {
 	// store AWS region in client struct, for region specific operations such as
 	// bucket storage in S3
 	client.region = c.Region
+	// rule ownership claims are only compared within a single plan or apply
+	client.ruleOwnership = newRuleOwnership()
 
 	log.Println("[INFO] Building AWS auth structure")
 	creds, err := GetCredentials(c)
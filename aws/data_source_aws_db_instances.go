@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsDbInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsDbInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": clientSideFiltersSchema(),
+			"tags":   tagsSchema(),
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsDbInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	filters, err := buildClientSideFilterList(d.Get("filter").(*schema.Set))
+	if err != nil {
+		return err
+	}
+	tags := d.Get("tags").(map[string]interface{})
+	needTags := len(tags) > 0 || clientSideFiltersReference(filters, "tags")
+
+	var dbInstances []*rds.DBInstance
+	err = conn.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{}, func(page *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+		dbInstances = append(dbInstances, page.DBInstances...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error reading DB Instances: %s", err)
+	}
+
+	var arns, ids []string
+	for _, dbInstance := range dbInstances {
+		object := flattenDbInstanceForFilter(dbInstance)
+
+		if needTags {
+			resp, err := conn.ListTagsForResource(&rds.ListTagsForResourceInput{
+				ResourceName: dbInstance.DBInstanceArn,
+			})
+			if err != nil {
+				return fmt.Errorf("error listing tags for DB Instance (%s): %s", aws.StringValue(dbInstance.DBInstanceArn), err)
+			}
+
+			instanceTags := tagsToMapRDS(resp.TagList)
+			if !clientSideTagsMatch(tags, instanceTags) {
+				continue
+			}
+			object["tags"] = instanceTags
+		}
+
+		if !clientSideFiltersMatch(filters, object) {
+			continue
+		}
+
+		arns = append(arns, aws.StringValue(dbInstance.DBInstanceArn))
+		ids = append(ids, aws.StringValue(dbInstance.DBInstanceIdentifier))
+	}
+
+	log.Printf("[DEBUG] Found %d DB Instances matching filters", len(ids))
+
+	d.SetId(resource.UniqueId())
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %s", err)
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids: %s", err)
+	}
+
+	return nil
+}
+
+// flattenDbInstanceForFilter flattens a DB Instance into the attributes,
+// named as in the aws_db_instance data source, which can be filtered on.
+func flattenDbInstanceForFilter(dbInstance *rds.DBInstance) map[string]interface{} {
+	m := map[string]interface{}{
+		"allocated_storage":       aws.Int64Value(dbInstance.AllocatedStorage),
+		"availability_zone":       aws.StringValue(dbInstance.AvailabilityZone),
+		"db_cluster_identifier":   aws.StringValue(dbInstance.DBClusterIdentifier),
+		"db_instance_arn":         aws.StringValue(dbInstance.DBInstanceArn),
+		"db_instance_class":       aws.StringValue(dbInstance.DBInstanceClass),
+		"db_instance_identifier":  aws.StringValue(dbInstance.DBInstanceIdentifier),
+		"db_instance_status":      aws.StringValue(dbInstance.DBInstanceStatus),
+		"db_name":                 aws.StringValue(dbInstance.DBName),
+		"engine":                  aws.StringValue(dbInstance.Engine),
+		"engine_version":          aws.StringValue(dbInstance.EngineVersion),
+		"multi_az":                aws.BoolValue(dbInstance.MultiAZ),
+		"publicly_accessible":     aws.BoolValue(dbInstance.PubliclyAccessible),
+		"replicate_source_db":     aws.StringValue(dbInstance.ReadReplicaSourceDBInstanceIdentifier),
+		"storage_encrypted":       aws.BoolValue(dbInstance.StorageEncrypted),
+		"storage_type":            aws.StringValue(dbInstance.StorageType),
+		"kms_key_id":              aws.StringValue(dbInstance.KmsKeyId),
+		"ca_cert_identifier":      aws.StringValue(dbInstance.CACertificateIdentifier),
+		"master_username":         aws.StringValue(dbInstance.MasterUsername),
+		"preferred_backup_window": aws.StringValue(dbInstance.PreferredBackupWindow),
+	}
+
+	if dbInstance.DBSubnetGroup != nil {
+		m["db_subnet_group"] = aws.StringValue(dbInstance.DBSubnetGroup.DBSubnetGroupName)
+		m["vpc_id"] = aws.StringValue(dbInstance.DBSubnetGroup.VpcId)
+	}
+
+	if dbInstance.Endpoint != nil {
+		m["address"] = aws.StringValue(dbInstance.Endpoint.Address)
+		m["port"] = aws.Int64Value(dbInstance.Endpoint.Port)
+	}
+
+	var vpcSecurityGroups []string
+	for _, v := range dbInstance.VpcSecurityGroups {
+		vpcSecurityGroups = append(vpcSecurityGroups, aws.StringValue(v.VpcSecurityGroupId))
+	}
+	m["vpc_security_groups"] = vpcSecurityGroups
+
+	return m
+}
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsDbInstances_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsDbInstancesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_db_instances.exact", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_db_instances.exact", "ids.0", "aws_db_instance.test", "identifier"),
+					resource.TestCheckResourceAttrPair("data.aws_db_instances.exact", "arns.0", "aws_db_instance.test", "arn"),
+					resource.TestCheckResourceAttr("data.aws_db_instances.wildcard", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_db_instances.tags", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_db_instances.none", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsDbInstancesConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier          = %[1]q
+  allocated_storage   = 10
+  engine              = "mysql"
+  instance_class      = "db.t2.micro"
+  name                = "test"
+  username            = "tfacctest"
+  password            = "avoid-plaintext-passwords"
+  skip_final_snapshot = true
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+data "aws_db_instances" "exact" {
+  filter {
+    name   = "db_instance_identifier"
+    values = ["${aws_db_instance.test.identifier}"]
+  }
+}
+
+data "aws_db_instances" "wildcard" {
+  filter {
+    name   = "db_instance_identifier"
+    values = ["${aws_db_instance.test.identifier}*"]
+    match  = "wildcard"
+  }
+
+  filter {
+    name   = "engine"
+    values = ["mysql"]
+  }
+}
+
+data "aws_db_instances" "tags" {
+  tags {
+    Name = "${aws_db_instance.test.tags["Name"]}"
+  }
+}
+
+data "aws_db_instances" "none" {
+  filter {
+    name   = "db_instance_identifier"
+    values = ["${aws_db_instance.test.identifier}"]
+  }
+
+  filter {
+    name   = "engine"
+    values = ["^postgres.*"]
+    match  = "regex"
+  }
+}
+`, rName)
+}
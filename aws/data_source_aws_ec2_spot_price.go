@@ -0,0 +1,184 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsEc2SpotPrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2SpotPriceRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"instance_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"product_descriptions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"spot_price": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spot_price_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spot_prices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"spot_price": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2SpotPriceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DescribeSpotPriceHistoryInput{}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.InstanceTypes = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("product_descriptions"); ok && v.(*schema.Set).Len() > 0 {
+		input.ProductDescriptions = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("filter"); ok {
+		input.Filters = buildEC2CustomFilterList(v.(*schema.Set))
+	}
+
+	id := fmt.Sprintf("%d", hashcode.String(input.String()))
+
+	// When the start and end times are equal the history contains the
+	// effective price of each instance type, zone and product as of that time.
+	now := time.Now()
+	input.StartTime = aws.Time(now)
+	input.EndTime = aws.Time(now)
+
+	log.Printf("[DEBUG] Reading EC2 Spot Price History: %s", input)
+	latest := make(map[string]*ec2.SpotPrice)
+	err := conn.DescribeSpotPriceHistoryPages(input, func(page *ec2.DescribeSpotPriceHistoryOutput, lastPage bool) bool {
+		for _, spotPrice := range page.SpotPriceHistory {
+			key := fmt.Sprintf("%s/%s/%s", aws.StringValue(spotPrice.AvailabilityZone), aws.StringValue(spotPrice.InstanceType), aws.StringValue(spotPrice.ProductDescription))
+
+			if v, ok := latest[key]; !ok || aws.TimeValue(spotPrice.Timestamp).After(aws.TimeValue(v.Timestamp)) {
+				latest[key] = spotPrice
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Spot Price History: %s", err)
+	}
+
+	if len(latest) == 0 {
+		return fmt.Errorf("no EC2 Spot Price History found matching criteria; try different search")
+	}
+
+	spotPrices := make([]*ec2.SpotPrice, 0, len(latest))
+	for _, spotPrice := range latest {
+		spotPrices = append(spotPrices, spotPrice)
+	}
+	sortEc2SpotPrices(spotPrices)
+
+	d.SetId(id)
+	d.Set("spot_price", spotPrices[0].SpotPrice)
+	d.Set("spot_price_timestamp", aws.TimeValue(spotPrices[0].Timestamp).Format(time.RFC3339))
+
+	if err := d.Set("spot_prices", flattenEc2SpotPrices(spotPrices)); err != nil {
+		return fmt.Errorf("error setting spot_prices: %s", err)
+	}
+
+	return nil
+}
+
+// sortEc2SpotPrices sorts spot prices from the cheapest to the most
+// expensive, breaking ties by availability zone, instance type and product.
+func sortEc2SpotPrices(spotPrices []*ec2.SpotPrice) {
+	price := func(spotPrice *ec2.SpotPrice) float64 {
+		f, err := strconv.ParseFloat(aws.StringValue(spotPrice.SpotPrice), 64)
+		if err != nil {
+			log.Printf("[WARN] Unable to parse EC2 Spot Price %q: %s", aws.StringValue(spotPrice.SpotPrice), err)
+		}
+		return f
+	}
+
+	sort.SliceStable(spotPrices, func(i, j int) bool {
+		a, b := spotPrices[i], spotPrices[j]
+		if pa, pb := price(a), price(b); pa != pb {
+			return pa < pb
+		}
+		if aws.StringValue(a.AvailabilityZone) != aws.StringValue(b.AvailabilityZone) {
+			return aws.StringValue(a.AvailabilityZone) < aws.StringValue(b.AvailabilityZone)
+		}
+		if aws.StringValue(a.InstanceType) != aws.StringValue(b.InstanceType) {
+			return aws.StringValue(a.InstanceType) < aws.StringValue(b.InstanceType)
+		}
+		return aws.StringValue(a.ProductDescription) < aws.StringValue(b.ProductDescription)
+	})
+}
+
+func flattenEc2SpotPrices(spotPrices []*ec2.SpotPrice) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(spotPrices))
+
+	for _, spotPrice := range spotPrices {
+		result = append(result, map[string]interface{}{
+			"availability_zone":   aws.StringValue(spotPrice.AvailabilityZone),
+			"instance_type":       aws.StringValue(spotPrice.InstanceType),
+			"product_description": aws.StringValue(spotPrice.ProductDescription),
+			"spot_price":          aws.StringValue(spotPrice.SpotPrice),
+			"timestamp":           aws.TimeValue(spotPrice.Timestamp).Format(time.RFC3339),
+		})
+	}
+
+	return result
+}
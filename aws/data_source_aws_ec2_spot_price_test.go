@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsEc2SpotPrice_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_spot_price.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsEc2SpotPriceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "spot_price", regexp.MustCompile(`^\d+\.\d+$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_price_timestamp"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "spot_price", dataSourceName, "spot_prices.0.spot_price"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "spot_prices.0.availability_zone", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "spot_prices.0.product_description", "Linux/UNIX"),
+				),
+			},
+		},
+	})
+}
+
+func TestSortEc2SpotPrices(t *testing.T) {
+	timestamp := time.Date(2018, 10, 1, 0, 0, 0, 0, time.UTC)
+	spotPrice := func(az, instanceType, price string) *ec2.SpotPrice {
+		return &ec2.SpotPrice{
+			AvailabilityZone:   aws.String(az),
+			InstanceType:       aws.String(instanceType),
+			ProductDescription: aws.String("Linux/UNIX"),
+			SpotPrice:          aws.String(price),
+			Timestamp:          aws.Time(timestamp),
+		}
+	}
+
+	spotPrices := []*ec2.SpotPrice{
+		spotPrice("us-west-2b", "m5.large", "0.0300"),
+		spotPrice("us-west-2a", "m5.large", "0.0300"),
+		spotPrice("us-west-2a", "c5.large", "0.0310"),
+		spotPrice("us-west-2c", "t3.large", "0.0250"),
+		spotPrice("us-west-2a", "m4.large", "0.100000"),
+	}
+	sortEc2SpotPrices(spotPrices)
+
+	expected := []string{
+		"us-west-2c t3.large",
+		"us-west-2a m5.large",
+		"us-west-2b m5.large",
+		"us-west-2a c5.large",
+		"us-west-2a m4.large",
+	}
+	var actual []string
+	for _, v := range spotPrices {
+		actual = append(actual, aws.StringValue(v.AvailabilityZone)+" "+aws.StringValue(v.InstanceType))
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+}
+
+const testAccDataSourceAwsEc2SpotPriceConfig = `
+data "aws_availability_zones" "available" {}
+
+data "aws_ec2_spot_price" "test" {
+  availability_zone    = "${data.aws_availability_zones.available.names[0]}"
+  instance_types       = ["t2.medium"]
+  product_descriptions = ["Linux/UNIX"]
+}
+`
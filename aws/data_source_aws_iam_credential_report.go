@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// iamCredentialReportStringColumns and iamCredentialReportBoolColumns list the
+// credential report CSV columns exported for each user, see
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/id_credentials_getting-report.html#id_credentials_understanding_the_report_format
+var iamCredentialReportStringColumns = []string{
+	"user",
+	"arn",
+	"user_creation_time",
+	"password_last_used",
+	"password_last_changed",
+	"password_next_rotation",
+	"access_key_1_last_rotated",
+	"access_key_1_last_used_date",
+	"access_key_1_last_used_region",
+	"access_key_1_last_used_service",
+	"access_key_2_last_rotated",
+	"access_key_2_last_used_date",
+	"access_key_2_last_used_region",
+	"access_key_2_last_used_service",
+	"cert_1_last_rotated",
+	"cert_2_last_rotated",
+}
+
+var iamCredentialReportBoolColumns = []string{
+	"password_enabled",
+	"mfa_active",
+	"access_key_1_active",
+	"access_key_2_active",
+	"cert_1_active",
+	"cert_2_active",
+}
+
+func dataSourceAwsIAMCredentialReport() *schema.Resource {
+	userSchema := map[string]*schema.Schema{}
+	for _, column := range iamCredentialReportStringColumns {
+		userSchema[column] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+	for _, column := range iamCredentialReportBoolColumns {
+		userSchema[column] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		Read: dataSourceAwsIAMCredentialReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"generated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: userSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMCredentialReportRead(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	// Generating a report is asynchronous, and a report less than four hours
+	// old is returned as COMPLETE straight away.
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		log.Printf("[DEBUG] Generating IAM Credential Report")
+		resp, err := iamconn.GenerateCredentialReport(&iam.GenerateCredentialReportInput{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if state := aws.StringValue(resp.State); state != iam.ReportStateTypeComplete {
+			return resource.RetryableError(fmt.Errorf("IAM Credential Report is in state %q", state))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error generating IAM Credential Report: %s", err)
+	}
+
+	var resp *iam.GetCredentialReportOutput
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		resp, err = iamconn.GetCredentialReport(&iam.GetCredentialReportInput{})
+
+		if isAWSErr(err, iam.ErrCodeCredentialReportNotReadyException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error reading IAM Credential Report: %s", err)
+	}
+
+	users, err := flattenIamCredentialReport(resp.Content)
+	if err != nil {
+		return fmt.Errorf("error parsing IAM Credential Report: %s", err)
+	}
+
+	generatedTime := aws.TimeValue(resp.GeneratedTime).Format(time.RFC3339)
+	d.SetId(generatedTime)
+	d.Set("generated_time", generatedTime)
+	if err := d.Set("users", users); err != nil {
+		return fmt.Errorf("error setting users: %s", err)
+	}
+
+	return nil
+}
+
+func flattenIamCredentialReport(content []byte) ([]map[string]interface{}, error) {
+	r := csv.NewReader(bytes.NewReader(content))
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]map[string]interface{}, 0)
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]string, len(header))
+		for i, column := range header {
+			if i < len(record) {
+				row[column] = record[i]
+			}
+		}
+
+		user := make(map[string]interface{})
+		for _, column := range iamCredentialReportStringColumns {
+			user[column] = row[column]
+		}
+		// Boolean columns may also hold "not_supported" (e.g. for the root
+		// account password), which is reported as false.
+		for _, column := range iamCredentialReportBoolColumns {
+			user[column] = row[column] == "true"
+		}
+
+		users = append(users, user)
+	}
+
+	return users, nil
+}
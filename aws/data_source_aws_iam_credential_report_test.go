@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestFlattenIamCredentialReport(t *testing.T) {
+	content := []byte(`user,arn,user_creation_time,password_enabled,password_last_used,password_last_changed,password_next_rotation,mfa_active,access_key_1_active,access_key_1_last_rotated,access_key_1_last_used_date,access_key_1_last_used_region,access_key_1_last_used_service,access_key_2_active,access_key_2_last_rotated,access_key_2_last_used_date,access_key_2_last_used_region,access_key_2_last_used_service,cert_1_active,cert_1_last_rotated,cert_2_active,cert_2_last_rotated
+<root_account>,arn:aws:iam::123456789012:root,2018-01-01T00:00:00+00:00,not_supported,2018-06-01T00:00:00+00:00,not_supported,not_supported,true,false,N/A,N/A,N/A,N/A,false,N/A,N/A,N/A,N/A,false,N/A,false,N/A
+alice,arn:aws:iam::123456789012:user/alice,2018-02-01T00:00:00+00:00,true,no_information,2018-02-01T00:00:00+00:00,N/A,false,true,2018-02-01T00:00:00+00:00,2018-06-01T00:00:00+00:00,us-east-1,s3,false,N/A,N/A,N/A,N/A,false,N/A,false,N/A
+`)
+
+	users, err := flattenIamCredentialReport(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(users))
+	}
+
+	root := users[0]
+	if root["user"] != "<root_account>" {
+		t.Fatalf("expected root account, got %q", root["user"])
+	}
+	if root["password_enabled"] != false {
+		t.Fatalf("expected root password_enabled to be false, got %#v", root["password_enabled"])
+	}
+	if root["mfa_active"] != true {
+		t.Fatalf("expected root mfa_active to be true, got %#v", root["mfa_active"])
+	}
+
+	expected := map[string]interface{}{
+		"user":                           "alice",
+		"arn":                            "arn:aws:iam::123456789012:user/alice",
+		"user_creation_time":             "2018-02-01T00:00:00+00:00",
+		"password_enabled":               true,
+		"password_last_used":             "no_information",
+		"password_last_changed":          "2018-02-01T00:00:00+00:00",
+		"password_next_rotation":         "N/A",
+		"mfa_active":                     false,
+		"access_key_1_active":            true,
+		"access_key_1_last_rotated":      "2018-02-01T00:00:00+00:00",
+		"access_key_1_last_used_date":    "2018-06-01T00:00:00+00:00",
+		"access_key_1_last_used_region":  "us-east-1",
+		"access_key_1_last_used_service": "s3",
+		"access_key_2_active":            false,
+		"access_key_2_last_rotated":      "N/A",
+		"access_key_2_last_used_date":    "N/A",
+		"access_key_2_last_used_region":  "N/A",
+		"access_key_2_last_used_service": "N/A",
+		"cert_1_active":                  false,
+		"cert_1_last_rotated":            "N/A",
+		"cert_2_active":                  false,
+		"cert_2_last_rotated":            "N/A",
+	}
+
+	if !reflect.DeepEqual(users[1], expected) {
+		t.Fatalf("expected:\n\n%#v\n\ngot:\n\n%#v", expected, users[1])
+	}
+}
+
+func TestAccAWSDataSourceIAMCredentialReport_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDataSourceIAMCredentialReportConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aws_iam_credential_report.test", "generated_time"),
+					resource.TestCheckResourceAttr("data.aws_iam_credential_report.test", "users.0.user", "<root_account>"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAwsDataSourceIAMCredentialReportConfig = `
+data "aws_iam_credential_report" "test" {}
+`
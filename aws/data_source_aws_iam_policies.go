@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIAMPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIAMPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"path_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  iam.PolicyScopeTypeAll,
+				ValidateFunc: validation.StringInSlice([]string{
+					iam.PolicyScopeTypeAll,
+					iam.PolicyScopeTypeAws,
+					iam.PolicyScopeTypeLocal,
+				}, false),
+			},
+			"only_attached": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	input := &iam.ListPoliciesInput{
+		OnlyAttached: aws.Bool(d.Get("only_attached").(bool)),
+		Scope:        aws.String(d.Get("scope").(string)),
+	}
+	if v, ok := d.GetOk("path_prefix"); ok {
+		input.PathPrefix = aws.String(v.(string))
+	}
+
+	var r *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		r = regexp.MustCompile(v.(string))
+	}
+
+	policies, err := listIamPolicies(iamconn, input, func(policy *iam.Policy) bool {
+		return r == nil || r.MatchString(aws.StringValue(policy.PolicyName))
+	})
+	if err != nil {
+		return fmt.Errorf("error reading IAM Policies: %s", err)
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return aws.StringValue(policies[i].PolicyName) < aws.StringValue(policies[j].PolicyName)
+	})
+
+	arns := make([]string, 0, len(policies))
+	names := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		arns = append(arns, aws.StringValue(policy.Arn))
+		names = append(names, aws.StringValue(policy.PolicyName))
+	}
+
+	d.SetId(resource.UniqueId())
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %s", err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %s", err)
+	}
+
+	return nil
+}
+
+// listIamPolicies returns all managed policies matching the given input for
+// which the match function returns true.
+func listIamPolicies(conn *iam.IAM, input *iam.ListPoliciesInput, match func(*iam.Policy) bool) ([]*iam.Policy, error) {
+	var policies []*iam.Policy
+
+	log.Printf("[DEBUG] Listing IAM Policies: %s", input)
+	err := conn.ListPoliciesPages(input, func(page *iam.ListPoliciesOutput, lastPage bool) bool {
+		for _, policy := range page.Policies {
+			if match(policy) {
+				policies = append(policies, policy)
+			}
+		}
+		return !lastPage
+	})
+
+	return policies, err
+}
@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMPolicies_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDataSourceIAMPoliciesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policies.test", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_iam_policies.test", "arns.#", "2"),
+					resource.TestCheckResourceAttrPair("data.aws_iam_policies.test", "names.0", "aws_iam_policy.test.0", "name"),
+					resource.TestCheckResourceAttrPair("data.aws_iam_policies.test", "arns.1", "aws_iam_policy.test.1", "arn"),
+					resource.TestCheckResourceAttr("data.aws_iam_policies.regex", "names.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_iam_policies.regex", "names.0", "aws_iam_policy.test.1", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicies_awsManaged(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDataSourceIAMPoliciesConfigAwsManaged,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policies.test", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_iam_policies.test", "names.0", "AdministratorAccess"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDataSourceIAMPoliciesConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  count = 2
+  name  = "%s-${count.index}"
+  path  = "/%s/"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "ec2:Describe*",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+data "aws_iam_policies" "test" {
+  path_prefix = "${aws_iam_policy.test.0.path}"
+  scope       = "Local"
+
+  depends_on = ["aws_iam_policy.test"]
+}
+
+data "aws_iam_policies" "regex" {
+  path_prefix = "${aws_iam_policy.test.0.path}"
+  name_regex  = "-1$"
+
+  depends_on = ["aws_iam_policy.test"]
+}
+`, rName, rName)
+}
+
+const testAccAwsDataSourceIAMPoliciesConfigAwsManaged = `
+data "aws_iam_policies" "test" {
+  scope      = "AWS"
+  name_regex = "^AdministratorAccess$"
+}
+`
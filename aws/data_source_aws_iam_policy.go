@@ -1,6 +1,10 @@
 package aws
 
 import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -10,12 +14,21 @@ func dataSourceAwsIAMPolicy() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name", "path_prefix"},
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"arn"},
+			},
+			"path_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"arn"},
 			},
 			"policy": {
 				Type:     schema.TypeString,
@@ -35,6 +48,40 @@ func dataSourceAwsIAMPolicy() *schema.Resource {
 }
 
 func dataSourceAwsIAMPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	d.SetId(d.Get("arn").(string))
+	iamconn := meta.(*AWSClient).iamconn
+
+	arn := d.Get("arn").(string)
+	name := d.Get("name").(string)
+
+	if arn == "" && name == "" {
+		return fmt.Errorf("one of `arn` or `name` must be set")
+	}
+
+	if arn == "" {
+		input := &iam.ListPoliciesInput{
+			Scope: aws.String(iam.PolicyScopeTypeAll),
+		}
+		if v, ok := d.GetOk("path_prefix"); ok {
+			input.PathPrefix = aws.String(v.(string))
+		}
+
+		policies, err := listIamPolicies(iamconn, input, func(policy *iam.Policy) bool {
+			return aws.StringValue(policy.PolicyName) == name
+		})
+		if err != nil {
+			return fmt.Errorf("error reading IAM Policy (%s): %s", name, err)
+		}
+
+		if len(policies) == 0 {
+			return fmt.Errorf("no IAM Policy found matching name %q", name)
+		}
+		if len(policies) > 1 {
+			return fmt.Errorf("%d IAM Policies found matching name %q, use `path_prefix` to narrow the search", len(policies), name)
+		}
+
+		arn = aws.StringValue(policies[0].Arn)
+	}
+
+	d.SetId(arn)
 	return resourceAwsIamPolicyRead(d, meta)
 }
@@ -32,6 +32,27 @@ func TestAccAWSDataSourceIAMPolicy_basic(t *testing.T) {
 
 }
 
+func TestAccAWSDataSourceIAMPolicy_name(t *testing.T) {
+	policyName := fmt.Sprintf("test-policy-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDataSourceIamPolicyConfigName(policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy.test", "name", policyName),
+					resource.TestCheckResourceAttr("data.aws_iam_policy.test", "description", "My test policy"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy.test", "path", "/testpath/"),
+					resource.TestCheckResourceAttrSet("data.aws_iam_policy.test", "policy"),
+					resource.TestCheckResourceAttrPair("data.aws_iam_policy.test", "arn", "aws_iam_policy.test_policy", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAwsDataSourceIamPolicyConfig(policyName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_policy" "test_policy" {
@@ -59,3 +80,33 @@ data "aws_iam_policy" "test" {
 }
 `, policyName)
 }
+
+func testAccAwsDataSourceIamPolicyConfigName(policyName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test_policy" {
+  name        = "%s"
+  path        = "/testpath/"
+  description = "My test policy"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "ec2:Describe*"
+      ],
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+data "aws_iam_policy" "test" {
+  name        = "${aws_iam_policy.test_policy.name}"
+  path_prefix = "/testpath/"
+}
+`, policyName)
+}
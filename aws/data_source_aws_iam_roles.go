@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIAMRoles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIAMRolesRead,
+
+		Schema: map[string]*schema.Schema{
+			"path_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMRolesRead(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	input := &iam.ListRolesInput{}
+	if v, ok := d.GetOk("path_prefix"); ok {
+		input.PathPrefix = aws.String(v.(string))
+	}
+
+	var r *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		r = regexp.MustCompile(v.(string))
+	}
+
+	var roles []*iam.Role
+	log.Printf("[DEBUG] Reading IAM Roles: %s", input)
+	err := iamconn.ListRolesPages(input, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		for _, role := range page.Roles {
+			if r != nil && !r.MatchString(aws.StringValue(role.RoleName)) {
+				continue
+			}
+			roles = append(roles, role)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error reading IAM Roles: %s", err)
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return aws.StringValue(roles[i].RoleName) < aws.StringValue(roles[j].RoleName)
+	})
+
+	arns := make([]string, 0, len(roles))
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		arns = append(arns, aws.StringValue(role.Arn))
+		names = append(names, aws.StringValue(role.RoleName))
+	}
+
+	d.SetId(resource.UniqueId())
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %s", err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %s", err)
+	}
+
+	return nil
+}
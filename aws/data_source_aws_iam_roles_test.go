@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMRoles_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDataSourceIAMRolesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_roles.test", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_iam_roles.test", "arns.#", "2"),
+					resource.TestCheckResourceAttrPair("data.aws_iam_roles.test", "names.0", "aws_iam_role.test.0", "name"),
+					resource.TestCheckResourceAttrPair("data.aws_iam_roles.test", "arns.1", "aws_iam_role.test.1", "arn"),
+					resource.TestCheckResourceAttr("data.aws_iam_roles.regex", "names.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_iam_roles.regex", "names.0", "aws_iam_role.test.1", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDataSourceIAMRolesConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  count = 2
+  name  = "%s-${count.index}"
+  path  = "/%s/"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+data "aws_iam_roles" "test" {
+  path_prefix = "${aws_iam_role.test.0.path}"
+
+  depends_on = ["aws_iam_role.test"]
+}
+
+data "aws_iam_roles" "regex" {
+  path_prefix = "${aws_iam_role.test.0.path}"
+  name_regex  = "-1$"
+
+  depends_on = ["aws_iam_role.test"]
+}
+`, rName, rName)
+}
@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIAMUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIAMUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"path_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMUsersRead(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	input := &iam.ListUsersInput{}
+	if v, ok := d.GetOk("path_prefix"); ok {
+		input.PathPrefix = aws.String(v.(string))
+	}
+
+	var r *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		r = regexp.MustCompile(v.(string))
+	}
+
+	var users []*iam.User
+	log.Printf("[DEBUG] Reading IAM Users: %s", input)
+	err := iamconn.ListUsersPages(input, func(page *iam.ListUsersOutput, lastPage bool) bool {
+		for _, user := range page.Users {
+			if r != nil && !r.MatchString(aws.StringValue(user.UserName)) {
+				continue
+			}
+			users = append(users, user)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error reading IAM Users: %s", err)
+	}
+
+	sort.Slice(users, func(i, j int) bool {
+		return aws.StringValue(users[i].UserName) < aws.StringValue(users[j].UserName)
+	})
+
+	arns := make([]string, 0, len(users))
+	names := make([]string, 0, len(users))
+	for _, user := range users {
+		arns = append(arns, aws.StringValue(user.Arn))
+		names = append(names, aws.StringValue(user.UserName))
+	}
+
+	d.SetId(resource.UniqueId())
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %s", err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %s", err)
+	}
+
+	return nil
+}
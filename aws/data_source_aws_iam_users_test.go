@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMUsers_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDataSourceIAMUsersConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_users.test", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_iam_users.test", "arns.#", "2"),
+					resource.TestCheckResourceAttrPair("data.aws_iam_users.test", "names.0", "aws_iam_user.test.0", "name"),
+					resource.TestCheckResourceAttrPair("data.aws_iam_users.test", "arns.1", "aws_iam_user.test.1", "arn"),
+					resource.TestCheckResourceAttr("data.aws_iam_users.regex", "names.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_iam_users.regex", "names.0", "aws_iam_user.test.1", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDataSourceIAMUsersConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  count = 2
+  name  = "%s-${count.index}"
+  path  = "/%s/"
+}
+
+data "aws_iam_users" "test" {
+  path_prefix = "${aws_iam_user.test.0.path}"
+
+  depends_on = ["aws_iam_user.test"]
+}
+
+data "aws_iam_users" "regex" {
+  path_prefix = "${aws_iam_user.test.0.path}"
+  name_regex  = "-1$"
+
+  depends_on = ["aws_iam_user.test"]
+}
+`, rName, rName)
+}
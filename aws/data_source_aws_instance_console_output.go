@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsInstanceConsoleOutput() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsInstanceConsoleOutputRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"latest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsInstanceConsoleOutputRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	instanceID := d.Get("instance_id").(string)
+	input := &ec2.GetConsoleOutputInput{
+		InstanceId: aws.String(instanceID),
+	}
+
+	// Only instances built on the Nitro system support retrieving the
+	// latest console output.
+	if d.Get("latest").(bool) {
+		input.Latest = aws.Bool(true)
+	}
+
+	output, timestamp, err := getAwsInstanceConsoleOutput(conn, input)
+	if err != nil {
+		return fmt.Errorf("error getting EC2 Instance (%s) console output: %s", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+	d.Set("output", output)
+
+	d.Set("timestamp", "")
+	if timestamp != nil {
+		d.Set("timestamp", aws.TimeValue(timestamp).Format(time.RFC3339))
+	}
+
+	return nil
+}
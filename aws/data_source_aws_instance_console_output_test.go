@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSInstanceConsoleOutputDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_instance_console_output.test"
+	resourceName := "aws_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConsoleOutputDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "latest", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "timestamp"),
+				),
+			},
+		},
+	})
+}
+
+const testAccInstanceConsoleOutputDataSourceConfig = `
+resource "aws_instance" "test" {
+  # us-west-2
+  ami           = "ami-4fccb37f"
+  instance_type = "m1.small"
+
+  tags {
+    Name = "tf-acc-test-instance-console-output"
+  }
+}
+
+data "aws_instance_console_output" "test" {
+  instance_id = "${aws_instance.test.id}"
+}
+`
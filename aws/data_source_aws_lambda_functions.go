@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLambdaFunctions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLambdaFunctionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": clientSideFiltersSchema(),
+			"tags":   tagsSchema(),
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"function_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsLambdaFunctionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+
+	filters, err := buildClientSideFilterList(d.Get("filter").(*schema.Set))
+	if err != nil {
+		return err
+	}
+	tags := d.Get("tags").(map[string]interface{})
+	needTags := len(tags) > 0 || clientSideFiltersReference(filters, "tags")
+
+	var functions []*lambda.FunctionConfiguration
+	err = conn.ListFunctionsPages(&lambda.ListFunctionsInput{}, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		functions = append(functions, page.Functions...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Lambda Functions: %s", err)
+	}
+
+	var arns, functionNames []string
+	for _, function := range functions {
+		object := flattenLambdaFunctionForFilter(function)
+
+		if needTags {
+			resp, err := conn.ListTags(&lambda.ListTagsInput{
+				Resource: function.FunctionArn,
+			})
+			if err != nil {
+				return fmt.Errorf("error listing tags for Lambda Function (%s): %s", aws.StringValue(function.FunctionArn), err)
+			}
+
+			functionTags := tagsToMapGeneric(resp.Tags)
+			if !clientSideTagsMatch(tags, functionTags) {
+				continue
+			}
+			object["tags"] = functionTags
+		}
+
+		if !clientSideFiltersMatch(filters, object) {
+			continue
+		}
+
+		arns = append(arns, aws.StringValue(function.FunctionArn))
+		functionNames = append(functionNames, aws.StringValue(function.FunctionName))
+	}
+
+	log.Printf("[DEBUG] Found %d Lambda Functions matching filters", len(functionNames))
+
+	d.SetId(resource.UniqueId())
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %s", err)
+	}
+
+	if err := d.Set("function_names", functionNames); err != nil {
+		return fmt.Errorf("error setting function_names: %s", err)
+	}
+
+	return nil
+}
+
+// flattenLambdaFunctionForFilter flattens a Lambda Function into the
+// attributes, named as in the aws_lambda_function data source, which can be
+// filtered on.
+func flattenLambdaFunctionForFilter(function *lambda.FunctionConfiguration) map[string]interface{} {
+	m := map[string]interface{}{
+		"arn":           aws.StringValue(function.FunctionArn),
+		"description":   aws.StringValue(function.Description),
+		"function_name": aws.StringValue(function.FunctionName),
+		"handler":       aws.StringValue(function.Handler),
+		"kms_key_arn":   aws.StringValue(function.KMSKeyArn),
+		"last_modified": aws.StringValue(function.LastModified),
+		"memory_size":   aws.Int64Value(function.MemorySize),
+		"role":          aws.StringValue(function.Role),
+		"runtime":       aws.StringValue(function.Runtime),
+		"timeout":       aws.Int64Value(function.Timeout),
+		"version":       aws.StringValue(function.Version),
+	}
+
+	if function.VpcConfig != nil {
+		m["vpc_config"] = map[string]interface{}{
+			"security_group_ids": aws.StringValueSlice(function.VpcConfig.SecurityGroupIds),
+			"subnet_ids":         aws.StringValueSlice(function.VpcConfig.SubnetIds),
+			"vpc_id":             aws.StringValue(function.VpcConfig.VpcId),
+		}
+	}
+
+	return m
+}
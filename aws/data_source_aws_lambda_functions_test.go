@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsLambdaFunctions_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLambdaFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsLambdaFunctionsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_lambda_functions.wildcard", "function_names.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_lambda_functions.regex", "function_names.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_lambda_functions.regex", "function_names.0", "aws_lambda_function.test.1", "function_name"),
+					resource.TestCheckResourceAttrPair("data.aws_lambda_functions.regex", "arns.0", "aws_lambda_function.test.1", "arn"),
+					resource.TestCheckResourceAttr("data.aws_lambda_functions.tags", "function_names.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_lambda_functions.tags", "function_names.0", "aws_lambda_function.test.0", "function_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsLambdaFunctionsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_lambda_function" "test" {
+  count         = 2
+  function_name = "%[1]s-${count.index}"
+  filename      = "test-fixtures/lambdatest.zip"
+  role          = "${aws_iam_role.test.arn}"
+  handler       = "exports.example"
+  runtime       = "nodejs8.10"
+  memory_size   = "${128 * (count.index + 1)}"
+
+  tags {
+    Index = "${count.index}"
+  }
+}
+
+data "aws_lambda_functions" "wildcard" {
+  filter {
+    name   = "function_name"
+    values = ["%[1]s-*"]
+    match  = "wildcard"
+  }
+
+  filter {
+    name   = "role"
+    values = ["${aws_lambda_function.test.0.role}"]
+  }
+}
+
+data "aws_lambda_functions" "regex" {
+  filter {
+    name   = "function_name"
+    values = ["${replace(aws_lambda_function.test.1.function_name, "/-1$/", "")}-[1-9]"]
+    match  = "regex"
+  }
+
+  filter {
+    name   = "memory_size"
+    values = ["256"]
+  }
+}
+
+data "aws_lambda_functions" "tags" {
+  filter {
+    name   = "function_name"
+    values = ["${aws_lambda_function.test.*.function_name}"]
+  }
+
+  tags {
+    Index = "0"
+  }
+}
+`, rName)
+}
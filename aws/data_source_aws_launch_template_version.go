@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLaunchTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLaunchTemplateVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			"launch_template_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"launch_template_name"},
+			},
+			"launch_template_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"launch_template_id"},
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "$Default",
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_version": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"version_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsLaunchTemplateVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		Versions: []*string{aws.String(d.Get("version").(string))},
+	}
+
+	if v, ok := d.GetOk("launch_template_id"); ok {
+		input.LaunchTemplateId = aws.String(v.(string))
+	} else if v, ok := d.GetOk("launch_template_name"); ok {
+		input.LaunchTemplateName = aws.String(v.(string))
+	} else {
+		return fmt.Errorf("one of `launch_template_id` or `launch_template_name` must be set")
+	}
+
+	log.Printf("[DEBUG] Reading Launch Template Version: %s", input)
+	resp, err := conn.DescribeLaunchTemplateVersions(input)
+	if err != nil {
+		return fmt.Errorf("error reading Launch Template Version: %s", err)
+	}
+
+	if resp == nil || len(resp.LaunchTemplateVersions) == 0 {
+		return fmt.Errorf("no Launch Template Version found matching version %q", d.Get("version").(string))
+	}
+
+	version := resp.LaunchTemplateVersions[0]
+
+	d.SetId(fmt.Sprintf("%s/%s", aws.StringValue(version.LaunchTemplateId), strconv.FormatInt(aws.Int64Value(version.VersionNumber), 10)))
+	d.Set("launch_template_id", version.LaunchTemplateId)
+	d.Set("launch_template_name", version.LaunchTemplateName)
+	d.Set("created_by", version.CreatedBy)
+	d.Set("default_version", version.DefaultVersion)
+	d.Set("version_description", version.VersionDescription)
+	d.Set("version_number", version.VersionNumber)
+
+	d.Set("create_time", "")
+	if version.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(version.CreateTime).Format(time.RFC3339))
+	}
+
+	return nil
+}
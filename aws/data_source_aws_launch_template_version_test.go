@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSLaunchTemplateVersionDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_launch_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateVersionDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aws_launch_template_version.default", "launch_template_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.aws_launch_template_version.default", "version_number", resourceName, "default_version"),
+					resource.TestCheckResourceAttr("data.aws_launch_template_version.default", "default_version", "true"),
+					resource.TestCheckResourceAttrSet("data.aws_launch_template_version.default", "create_time"),
+					resource.TestCheckResourceAttr("data.aws_launch_template_version.latest", "launch_template_name", rName),
+					resource.TestCheckResourceAttrPair("data.aws_launch_template_version.latest", "version_number", resourceName, "latest_version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLaunchTemplateVersionDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %q
+  instance_type = "t2.micro"
+}
+
+data "aws_launch_template_version" "default" {
+  launch_template_id = "${aws_launch_template.test.id}"
+}
+
+data "aws_launch_template_version" "latest" {
+  launch_template_name = "${aws_launch_template.test.name}"
+  version              = "$Latest"
+}
+`, rName)
+}
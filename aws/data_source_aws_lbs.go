@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLbs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLbsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": clientSideFiltersSchema(),
+			"tags":   tagsSchema(),
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsLbsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elbv2conn
+
+	filters, err := buildClientSideFilterList(d.Get("filter").(*schema.Set))
+	if err != nil {
+		return err
+	}
+	tags := d.Get("tags").(map[string]interface{})
+	needTags := len(tags) > 0 || clientSideFiltersReference(filters, "tags")
+
+	var lbs []*elbv2.LoadBalancer
+	err = conn.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+		lbs = append(lbs, page.LoadBalancers...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error describing Load Balancers: %s", err)
+	}
+
+	var lbTags map[string]map[string]string
+	if needTags {
+		lbTags, err = describeLbsTags(conn, lbs)
+		if err != nil {
+			return err
+		}
+	}
+
+	var arns, names []string
+	for _, lb := range lbs {
+		object := flattenLbForFilter(lb)
+
+		if needTags {
+			if !clientSideTagsMatch(tags, lbTags[aws.StringValue(lb.LoadBalancerArn)]) {
+				continue
+			}
+			object["tags"] = lbTags[aws.StringValue(lb.LoadBalancerArn)]
+		}
+
+		if !clientSideFiltersMatch(filters, object) {
+			continue
+		}
+
+		arns = append(arns, aws.StringValue(lb.LoadBalancerArn))
+		names = append(names, aws.StringValue(lb.LoadBalancerName))
+	}
+
+	log.Printf("[DEBUG] Found %d Load Balancers matching filters", len(arns))
+
+	d.SetId(resource.UniqueId())
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %s", err)
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %s", err)
+	}
+
+	return nil
+}
+
+// describeLbsTags returns the tags of the given load balancers, keyed by ARN.
+func describeLbsTags(conn *elbv2.ELBV2, lbs []*elbv2.LoadBalancer) (map[string]map[string]string, error) {
+	tags := make(map[string]map[string]string, len(lbs))
+
+	// At most 20 resources can be described per request.
+	for i := 0; i < len(lbs); i += 20 {
+		j := i + 20
+		if j > len(lbs) {
+			j = len(lbs)
+		}
+
+		arns := make([]*string, 0, j-i)
+		for _, lb := range lbs[i:j] {
+			arns = append(arns, lb.LoadBalancerArn)
+		}
+
+		resp, err := conn.DescribeTags(&elbv2.DescribeTagsInput{
+			ResourceArns: arns,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error describing Load Balancer tags: %s", err)
+		}
+
+		for _, description := range resp.TagDescriptions {
+			tags[aws.StringValue(description.ResourceArn)] = tagsToMapELBv2(description.Tags)
+		}
+	}
+
+	return tags, nil
+}
+
+// flattenLbForFilter flattens a Load Balancer into the attributes, named as
+// in the aws_lb data source, which can be filtered on.
+func flattenLbForFilter(lb *elbv2.LoadBalancer) map[string]interface{} {
+	m := map[string]interface{}{
+		"arn":                aws.StringValue(lb.LoadBalancerArn),
+		"dns_name":           aws.StringValue(lb.DNSName),
+		"internal":           aws.StringValue(lb.Scheme) == elbv2.LoadBalancerSchemeEnumInternal,
+		"ip_address_type":    aws.StringValue(lb.IpAddressType),
+		"load_balancer_type": aws.StringValue(lb.Type),
+		"name":               aws.StringValue(lb.LoadBalancerName),
+		"security_groups":    aws.StringValueSlice(lb.SecurityGroups),
+		"vpc_id":             aws.StringValue(lb.VpcId),
+		"zone_id":            aws.StringValue(lb.CanonicalHostedZoneId),
+	}
+
+	if lb.State != nil {
+		m["state"] = aws.StringValue(lb.State.Code)
+	}
+
+	var subnets, availabilityZones []string
+	for _, az := range lb.AvailabilityZones {
+		subnets = append(subnets, aws.StringValue(az.SubnetId))
+		availabilityZones = append(availabilityZones, aws.StringValue(az.ZoneName))
+	}
+	m["subnets"] = subnets
+	m["availability_zones"] = availabilityZones
+
+	return m
+}
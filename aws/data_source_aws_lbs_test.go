@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsLbs_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsLbsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_lbs.vpc", "arns.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_lbs.vpc", "arns.0", "aws_lb.test", "arn"),
+					resource.TestCheckResourceAttrPair("data.aws_lbs.vpc", "names.0", "aws_lb.test", "name"),
+					resource.TestCheckResourceAttr("data.aws_lbs.tags", "arns.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_lbs.external", "arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsLbsConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-lbs-data-source"
+  }
+}
+
+resource "aws_subnet" "test" {
+  count             = 2
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "${cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)}"
+  availability_zone = "${element(data.aws_availability_zones.available.names, count.index)}"
+
+  tags {
+    Name = "tf-acc-lbs-data-source"
+  }
+}
+
+resource "aws_lb" "test" {
+  name     = %[1]q
+  internal = true
+  subnets  = ["${aws_subnet.test.*.id}"]
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+data "aws_lbs" "vpc" {
+  filter {
+    name   = "vpc_id"
+    values = ["${aws_lb.test.vpc_id}"]
+  }
+
+  filter {
+    name   = "subnets"
+    values = ["${aws_subnet.test.0.id}"]
+  }
+}
+
+data "aws_lbs" "tags" {
+  tags {
+    Name = "${aws_lb.test.tags["Name"]}"
+  }
+}
+
+data "aws_lbs" "external" {
+  filter {
+    name   = "vpc_id"
+    values = ["${aws_lb.test.vpc_id}"]
+  }
+
+  filter {
+    name   = "internal"
+    values = ["false"]
+  }
+}
+`, rName)
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"log"
+	"sort"
 
 	"encoding/json"
 	"fmt"
@@ -37,10 +38,83 @@ func dataSourceAwsPricingProduct() *schema.Resource {
 					},
 				},
 			},
+			"multiple_results": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"result": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"products": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sku": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"price_dimensions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"term_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"offer_term_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"effective_date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"term_attributes": {
+										Type:     schema.TypeMap,
+										Computed: true,
+									},
+									"rate_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"unit": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"begin_range": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"end_range": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"price_per_unit": {
+										Type:     schema.TypeMap,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -64,16 +138,28 @@ func dataSourceAwsPricingProductRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	log.Printf("[DEBUG] Reading pricing of products: %s", params)
-	resp, err := conn.GetProducts(params)
-	if err != nil {
-		return fmt.Errorf("Error reading pricing of products: %s", err)
+	var priceList []aws.JSONValue
+	if d.Get("multiple_results").(bool) {
+		err := conn.GetProductsPages(params, func(page *pricing.GetProductsOutput, lastPage bool) bool {
+			priceList = append(priceList, page.PriceList...)
+			return !lastPage
+		})
+		if err != nil {
+			return fmt.Errorf("Error reading pricing of products: %s", err)
+		}
+	} else {
+		resp, err := conn.GetProducts(params)
+		if err != nil {
+			return fmt.Errorf("Error reading pricing of products: %s", err)
+		}
+		priceList = resp.PriceList
 	}
 
-	numberOfElements := len(resp.PriceList)
+	numberOfElements := len(priceList)
 	if numberOfElements == 0 {
 		return fmt.Errorf("Pricing product query did not return any elements")
-	} else if numberOfElements > 1 {
-		priceListBytes, err := json.Marshal(resp.PriceList)
+	} else if numberOfElements > 1 && !d.Get("multiple_results").(bool) {
+		priceListBytes, err := json.Marshal(priceList)
 		priceListString := string(priceListBytes)
 		if err != nil {
 			priceListString = err.Error()
@@ -81,12 +167,105 @@ func dataSourceAwsPricingProductRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Pricing product query not precise enough. Returned more than one element: %s", priceListString)
 	}
 
-	pricingResult, err := json.Marshal(resp.PriceList[0])
-	if err != nil {
-		return fmt.Errorf("Invalid JSON value returned by AWS: %s", err)
+	products := make([]map[string]interface{}, 0, numberOfElements)
+	for _, v := range priceList {
+		products = append(products, flattenPricingProduct(v))
 	}
 
 	d.SetId(fmt.Sprintf("%d", hashcode.String(params.String())))
-	d.Set("result", string(pricingResult))
+
+	if numberOfElements == 1 {
+		pricingResult, err := json.Marshal(priceList[0])
+		if err != nil {
+			return fmt.Errorf("Invalid JSON value returned by AWS: %s", err)
+		}
+		d.Set("result", string(pricingResult))
+	} else {
+		d.Set("result", "")
+	}
+
+	if err := d.Set("products", products); err != nil {
+		return fmt.Errorf("error setting products: %s", err)
+	}
+
 	return nil
 }
+
+// flattenPricingProduct parses a price list document, as returned by
+// GetProducts, into its product attributes and a price dimension per rate
+// of each of its terms.
+func flattenPricingProduct(priceList aws.JSONValue) map[string]interface{} {
+	product := pricingJSONObject(priceList["product"])
+
+	m := map[string]interface{}{
+		"sku":              pricingJSONString(product["sku"]),
+		"product_family":   pricingJSONString(product["productFamily"]),
+		"attributes":       pricingJSONStringMap(product["attributes"]),
+		"price_dimensions": []map[string]interface{}{},
+	}
+
+	var dimensions []map[string]interface{}
+	for termType, termsI := range pricingJSONObject(priceList["terms"]) {
+		for _, termI := range pricingJSONObject(termsI) {
+			term := pricingJSONObject(termI)
+
+			for _, dimensionI := range pricingJSONObject(term["priceDimensions"]) {
+				dimension := pricingJSONObject(dimensionI)
+
+				dimensions = append(dimensions, map[string]interface{}{
+					"term_type":       termType,
+					"offer_term_code": pricingJSONString(term["offerTermCode"]),
+					"effective_date":  pricingJSONString(term["effectiveDate"]),
+					"term_attributes": pricingJSONStringMap(term["termAttributes"]),
+					"rate_code":       pricingJSONString(dimension["rateCode"]),
+					"description":     pricingJSONString(dimension["description"]),
+					"unit":            pricingJSONString(dimension["unit"]),
+					"begin_range":     pricingJSONString(dimension["beginRange"]),
+					"end_range":       pricingJSONString(dimension["endRange"]),
+					"price_per_unit":  pricingJSONStringMap(dimension["pricePerUnit"]),
+				})
+			}
+		}
+	}
+
+	// Terms and their dimensions are keyed objects, so sort for stable output.
+	sort.Slice(dimensions, func(i, j int) bool {
+		a, b := dimensions[i], dimensions[j]
+		if a["term_type"] != b["term_type"] {
+			return a["term_type"].(string) < b["term_type"].(string)
+		}
+		if a["offer_term_code"] != b["offer_term_code"] {
+			return a["offer_term_code"].(string) < b["offer_term_code"].(string)
+		}
+		return a["rate_code"].(string) < b["rate_code"].(string)
+	})
+	if dimensions != nil {
+		m["price_dimensions"] = dimensions
+	}
+
+	return m
+}
+
+func pricingJSONObject(v interface{}) map[string]interface{} {
+	if m, ok := v.(map[string]interface{}); ok {
+		return m
+	}
+	return map[string]interface{}{}
+}
+
+func pricingJSONString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return ""
+}
+
+func pricingJSONStringMap(v interface{}) map[string]string {
+	m := make(map[string]string)
+	for k, e := range pricingJSONObject(v) {
+		if s, ok := e.(string); ok {
+			m[k] = s
+		}
+	}
+	return m
+}
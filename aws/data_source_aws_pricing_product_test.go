@@ -4,8 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"reflect"
+	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -23,12 +26,154 @@ func TestAccDataSourceAwsPricingProduct_ec2(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.aws_pricing_product.test", "result"),
 					testAccPricingCheckValueIsJSON("data.aws_pricing_product.test"),
+					resource.TestCheckResourceAttr("data.aws_pricing_product.test", "products.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_pricing_product.test", "products.0.attributes.instanceType", "c5.large"),
+					resource.TestCheckResourceAttr("data.aws_pricing_product.test", "products.0.price_dimensions.0.term_type", "OnDemand"),
+					resource.TestCheckResourceAttrSet("data.aws_pricing_product.test", "products.0.price_dimensions.0.price_per_unit.USD"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccDataSourceAwsPricingProduct_multipleResults(t *testing.T) {
+	oldRegion := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldRegion)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsPricingProductConfigMultipleResults(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_pricing_product.test", "result", ""),
+					resource.TestMatchResourceAttr("data.aws_pricing_product.test", "products.#", regexp.MustCompile(`^([2-9]|[1-9][0-9]+)$`)),
+					resource.TestCheckResourceAttrSet("data.aws_pricing_product.test", "products.0.sku"),
+					resource.TestCheckResourceAttr("data.aws_pricing_product.test", "products.0.product_family", "Compute Instance"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenPricingProduct(t *testing.T) {
+	priceList := aws.JSONValue{
+		"product": map[string]interface{}{
+			"productFamily": "Compute Instance",
+			"sku":           "ABCD",
+			"attributes": map[string]interface{}{
+				"instanceType": "c5.large",
+				"vcpu":         "2",
+			},
+		},
+		"serviceCode": "AmazonEC2",
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"ABCD.JRTCKXETXF": map[string]interface{}{
+					"offerTermCode": "JRTCKXETXF",
+					"effectiveDate": "2018-09-01T00:00:00Z",
+					"priceDimensions": map[string]interface{}{
+						"ABCD.JRTCKXETXF.6YS6EN2CT7": map[string]interface{}{
+							"rateCode":     "ABCD.JRTCKXETXF.6YS6EN2CT7",
+							"description":  "$0.085 per On Demand Linux c5.large Instance Hour",
+							"unit":         "Hrs",
+							"beginRange":   "0",
+							"endRange":     "Inf",
+							"appliesTo":    []interface{}{},
+							"pricePerUnit": map[string]interface{}{"USD": "0.0850000000"},
+						},
+					},
+					"termAttributes": map[string]interface{}{},
+				},
+			},
+			"Reserved": map[string]interface{}{
+				"ABCD.38NPMPTW36": map[string]interface{}{
+					"offerTermCode": "38NPMPTW36",
+					"effectiveDate": "2018-09-01T00:00:00Z",
+					"priceDimensions": map[string]interface{}{
+						"ABCD.38NPMPTW36.2TG2D8R56U": map[string]interface{}{
+							"rateCode":     "ABCD.38NPMPTW36.2TG2D8R56U",
+							"description":  "Upfront Fee",
+							"unit":         "Quantity",
+							"pricePerUnit": map[string]interface{}{"USD": "220"},
+						},
+						"ABCD.38NPMPTW36.6YS6EN2CT7": map[string]interface{}{
+							"rateCode":     "ABCD.38NPMPTW36.6YS6EN2CT7",
+							"description":  "c5.large reserved instance applied",
+							"unit":         "Hrs",
+							"beginRange":   "0",
+							"endRange":     "Inf",
+							"pricePerUnit": map[string]interface{}{"USD": "0.0250000000"},
+						},
+					},
+					"termAttributes": map[string]interface{}{
+						"LeaseContractLength": "1yr",
+						"PurchaseOption":      "Partial Upfront",
+					},
+				},
+			},
+		},
+	}
+
+	expected := map[string]interface{}{
+		"sku":            "ABCD",
+		"product_family": "Compute Instance",
+		"attributes": map[string]string{
+			"instanceType": "c5.large",
+			"vcpu":         "2",
+		},
+		"price_dimensions": []map[string]interface{}{
+			{
+				"term_type":       "OnDemand",
+				"offer_term_code": "JRTCKXETXF",
+				"effective_date":  "2018-09-01T00:00:00Z",
+				"term_attributes": map[string]string{},
+				"rate_code":       "ABCD.JRTCKXETXF.6YS6EN2CT7",
+				"description":     "$0.085 per On Demand Linux c5.large Instance Hour",
+				"unit":            "Hrs",
+				"begin_range":     "0",
+				"end_range":       "Inf",
+				"price_per_unit":  map[string]string{"USD": "0.0850000000"},
+			},
+			{
+				"term_type":       "Reserved",
+				"offer_term_code": "38NPMPTW36",
+				"effective_date":  "2018-09-01T00:00:00Z",
+				"term_attributes": map[string]string{
+					"LeaseContractLength": "1yr",
+					"PurchaseOption":      "Partial Upfront",
+				},
+				"rate_code":      "ABCD.38NPMPTW36.2TG2D8R56U",
+				"description":    "Upfront Fee",
+				"unit":           "Quantity",
+				"begin_range":    "",
+				"end_range":      "",
+				"price_per_unit": map[string]string{"USD": "220"},
+			},
+			{
+				"term_type":       "Reserved",
+				"offer_term_code": "38NPMPTW36",
+				"effective_date":  "2018-09-01T00:00:00Z",
+				"term_attributes": map[string]string{
+					"LeaseContractLength": "1yr",
+					"PurchaseOption":      "Partial Upfront",
+				},
+				"rate_code":      "ABCD.38NPMPTW36.6YS6EN2CT7",
+				"description":    "c5.large reserved instance applied",
+				"unit":           "Hrs",
+				"begin_range":    "0",
+				"end_range":      "Inf",
+				"price_per_unit": map[string]string{"USD": "0.0250000000"},
+			},
+		},
+	}
+
+	if actual := flattenPricingProduct(priceList); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected:\n%#v\n\ngot:\n%#v", expected, actual)
+	}
+}
+
 func TestAccDataSourceAwsPricingProduct_redshift(t *testing.T) {
 	oldRegion := os.Getenv("AWS_DEFAULT_REGION")
 	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
@@ -100,6 +245,37 @@ func testAccDataSourceAwsPricingProductConfigRedshift() string {
 `)
 }
 
+func testAccDataSourceAwsPricingProductConfigMultipleResults() string {
+	return `data "aws_pricing_product" "test" {
+  service_code     = "AmazonEC2"
+  multiple_results = true
+
+  filters = [
+    {
+      field = "instanceFamily"
+      value = "Compute optimized"
+    },
+    {
+      field = "operatingSystem"
+      value = "Linux"
+    },
+    {
+      field = "location"
+      value = "US East (N. Virginia)"
+    },
+    {
+      field = "preInstalledSw"
+      value = "NA"
+    },
+    {
+      field = "tenancy"
+      value = "Shared"
+    },
+  ]
+}
+`
+}
+
 func testAccPricingCheckValueIsJSON(data string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[data]
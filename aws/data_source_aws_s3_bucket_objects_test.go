@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAWSS3BucketObjects_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	dataSourceName := "data.aws_s3_bucket_objects.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt),
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt) + `
+data "aws_s3_bucket_objects" "test" {
+  bucket = "${aws_s3_bucket.objects_bucket.id}"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.3", "readme.txt"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "owners.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjects_prefixAndDelimiter(t *testing.T) {
+	rInt := acctest.RandInt()
+	dataSourceName := "data.aws_s3_bucket_objects.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt),
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt) + `
+data "aws_s3_bucket_objects" "test" {
+  bucket    = "${aws_s3_bucket.objects_bucket.id}"
+  prefix    = "arch/"
+  delimiter = "/"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.0", "arch/navajo/"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.1", "arch/rubicon/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjects_startAfterAndMaxKeys(t *testing.T) {
+	rInt := acctest.RandInt()
+	dataSourceName := "data.aws_s3_bucket_objects.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt),
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt) + `
+data "aws_s3_bucket_objects" "test" {
+  bucket      = "${aws_s3_bucket.objects_bucket.id}"
+  start_after = "arch/navajo/north_window"
+  max_keys    = 2
+  fetch_owner = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "arch/navajo/sand_painting"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.1", "arch/rubicon/three_gossips"),
+					resource.TestCheckResourceAttr(dataSourceName, "owners.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSourceS3ObjectsConfigResources(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "objects_bucket" {
+  bucket = "tf-objects-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object1" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/navajo/north_window"
+  content = "Delicate"
+}
+
+resource "aws_s3_bucket_object" "object2" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/navajo/sand_painting"
+  content = "Balanced Rock"
+}
+
+resource "aws_s3_bucket_object" "object3" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/rubicon/three_gossips"
+  content = "Park Avenue"
+}
+
+resource "aws_s3_bucket_object" "object4" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "readme.txt"
+  content = "Arches"
+}
+`, randInt)
+}
@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsSecretsManagerRandomPassword() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSecretsManagerRandomPasswordRead,
+
+		Schema: map[string]*schema.Schema{
+			"exclude_characters": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"exclude_lowercase": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude_numbers": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude_punctuation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude_uppercase": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"include_space": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"password_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      32,
+				ValidateFunc: validation.IntBetween(1, 4096),
+			},
+			"require_each_included_type": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"random_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsSecretsManagerRandomPasswordRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).secretsmanagerconn
+
+	input := &secretsmanager.GetRandomPasswordInput{
+		ExcludeLowercase:        aws.Bool(d.Get("exclude_lowercase").(bool)),
+		ExcludeNumbers:          aws.Bool(d.Get("exclude_numbers").(bool)),
+		ExcludePunctuation:      aws.Bool(d.Get("exclude_punctuation").(bool)),
+		ExcludeUppercase:        aws.Bool(d.Get("exclude_uppercase").(bool)),
+		IncludeSpace:            aws.Bool(d.Get("include_space").(bool)),
+		PasswordLength:          aws.Int64(int64(d.Get("password_length").(int))),
+		RequireEachIncludedType: aws.Bool(d.Get("require_each_included_type").(bool)),
+	}
+
+	if v, ok := d.GetOk("exclude_characters"); ok {
+		input.ExcludeCharacters = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Getting Secrets Manager random password: %s", input)
+	output, err := conn.GetRandomPassword(input)
+	if err != nil {
+		return fmt.Errorf("error getting Secrets Manager random password: %s", err)
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("random_password", output.RandomPassword)
+
+	return nil
+}
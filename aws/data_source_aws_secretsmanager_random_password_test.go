@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsSecretsManagerRandomPassword_Basic(t *testing.T) {
+	datasourceName := "data.aws_secretsmanager_random_password.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsSecretsManagerRandomPasswordConfig(40),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(datasourceName, "random_password", regexp.MustCompile(`^[A-Za-z0-9]{40}$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsSecretsManagerRandomPasswordConfig(passwordLength int) string {
+	return fmt.Sprintf(`
+data "aws_secretsmanager_random_password" "test" {
+  password_length     = %d
+  exclude_punctuation = true
+}
+`, passwordLength)
+}
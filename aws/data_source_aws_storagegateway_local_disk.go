@@ -39,47 +39,59 @@ func dataSourceAwsStorageGatewayLocalDisk() *schema.Resource {
 func dataSourceAwsStorageGatewayLocalDiskRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).storagegatewayconn
 
+	disk, err := storageGatewayLocalDisk(conn, d.Get("gateway_arn").(string), func(disk *storagegateway.Disk) bool {
+		if v, ok := d.GetOk("disk_node"); ok && v.(string) == aws.StringValue(disk.DiskNode) {
+			return true
+		}
+		if v, ok := d.GetOk("disk_path"); ok && v.(string) == aws.StringValue(disk.DiskPath) {
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(aws.StringValue(disk.DiskId))
+	d.Set("disk_id", disk.DiskId)
+	d.Set("disk_node", disk.DiskNode)
+	d.Set("disk_path", disk.DiskPath)
+
+	return nil
+}
+
+// storageGatewayLocalDisk returns the single local disk of the gateway for
+// which match returns true.
+func storageGatewayLocalDisk(conn *storagegateway.StorageGateway, gatewayARN string, match func(*storagegateway.Disk) bool) (*storagegateway.Disk, error) {
 	input := &storagegateway.ListLocalDisksInput{
-		GatewayARN: aws.String(d.Get("gateway_arn").(string)),
+		GatewayARN: aws.String(gatewayARN),
 	}
 
 	log.Printf("[DEBUG] Reading Storage Gateway Local Disk: %s", input)
 	output, err := conn.ListLocalDisks(input)
 	if err != nil {
-		return fmt.Errorf("error reading Storage Gateway Local Disk: %s", err)
+		return nil, fmt.Errorf("error reading Storage Gateway Local Disk: %s", err)
 	}
 
 	if output == nil || len(output.Disks) == 0 {
-		return errors.New("no results found for query, try adjusting your search criteria")
+		return nil, errors.New("no results found for query, try adjusting your search criteria")
 	}
 
 	var matchingDisks []*storagegateway.Disk
 
 	for _, disk := range output.Disks {
-		if v, ok := d.GetOk("disk_node"); ok && v.(string) == aws.StringValue(disk.DiskNode) {
+		if match(disk) {
 			matchingDisks = append(matchingDisks, disk)
-			continue
-		}
-		if v, ok := d.GetOk("disk_path"); ok && v.(string) == aws.StringValue(disk.DiskPath) {
-			matchingDisks = append(matchingDisks, disk)
-			continue
 		}
 	}
 
 	if len(matchingDisks) == 0 {
-		return errors.New("no results found for query, try adjusting your search criteria")
+		return nil, errors.New("no results found for query, try adjusting your search criteria")
 	}
 
 	if len(matchingDisks) > 1 {
-		return errors.New("multiple results found for query, try adjusting your search criteria")
+		return nil, errors.New("multiple results found for query, try adjusting your search criteria")
 	}
 
-	disk := matchingDisks[0]
-
-	d.SetId(aws.StringValue(disk.DiskId))
-	d.Set("disk_id", disk.DiskId)
-	d.Set("disk_node", disk.DiskNode)
-	d.Set("disk_path", disk.DiskPath)
-
-	return nil
+	return matchingDisks[0], nil
 }
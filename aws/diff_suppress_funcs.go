@@ -102,3 +102,10 @@ func suppressAutoscalingGroupAvailabilityZoneDiffs(k, old, new string, d *schema
 func suppressRoute53ZoneNameWithTrailingDot(k, old, new string, d *schema.ResourceData) bool {
 	return strings.TrimSuffix(old, ".") == strings.TrimSuffix(new, ".")
 }
+
+// suppressMissingOptionalConfigurationBlock suppresses the diff when an optional
+// configuration block with defaults is omitted from the configuration, but the
+// API response includes those defaults and they are refreshed into the state.
+func suppressMissingOptionalConfigurationBlock(k, old, new string, d *schema.ResourceData) bool {
+	return old == "1" && new == "0"
+}
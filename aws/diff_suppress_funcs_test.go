@@ -70,3 +70,39 @@ func TestSuppressEquivalentTypeStringBoolean(t *testing.T) {
 		}
 	}
 }
+
+func TestSuppressMissingOptionalConfigurationBlock(t *testing.T) {
+	testCases := []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        "1",
+			new:        "0",
+			equivalent: true,
+		},
+		{
+			old:        "0",
+			new:        "1",
+			equivalent: false,
+		},
+		{
+			old:        "1",
+			new:        "1",
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		value := suppressMissingOptionalConfigurationBlock("test_property.#", tc.old, tc.new, nil)
+
+		if tc.equivalent && !value {
+			t.Fatalf("expected test case %d to be equivalent", i)
+		}
+
+		if !tc.equivalent && value {
+			t.Fatalf("expected test case %d to not be equivalent", i)
+		}
+	}
+}
@@ -31,6 +31,9 @@ func resourceAwsNetworkAclImportState(
 		2+len(acl.Associations)+len(acl.Entries))
 	results[0] = d
 
+	// Imported rules are managed exclusively, as with the argument's default
+	d.Set("exclusive", true)
+
 	/*
 		{
 			// Construct the entries
@@ -31,6 +31,10 @@ func resourceAwsRouteTableImportState(
 	results := make([]*schema.ResourceData, 1,
 		2+len(table.Associations)+len(table.Routes))
 	results[0] = d
+
+	// Imported rules are managed exclusively, as with the argument's default
+	d.Set("exclusive", true)
+
 	log.Print("[WARN] RouteTable imports will be handled differently in a future version.")
 	log.Printf("[WARN] This import will create %d resources (aws_route_table, aws_route, aws_route_table_association).", len(results))
 	log.Print("[WARN] In the future, only 1 aws_route_table resource will be created with inline routes.")
@@ -30,6 +30,9 @@ func resourceAwsSecurityGroupImportState(
 		1+len(sg.IpPermissions)+len(sg.IpPermissionsEgress))
 	results[0] = d
 
+	// Imported rules are managed exclusively, as with the argument's default
+	d.Set("exclusive", true)
+
 	// Construct the rules
 	permMap := map[string][]*ec2.IpPermission{
 		"ingress": sg.IpPermissions,
@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform/helper/encryption"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// encryptValueWithPgpKey encrypts value with pgpKey, which is either a base-64
+// encoded PGP public key or a keybase username in the form keybase:username.
+// It returns the fingerprint of the key used and the base-64 encoded ciphertext.
+func encryptValueWithPgpKey(pgpKey, value, description string) (string, string, error) {
+	encryptionKey, err := encryption.RetrieveGPGKey(pgpKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return encryption.EncryptValue(encryptionKey, value, description)
+}
+
+// setPgpEncryptedAttribute sets attr to value, unless the resource has a
+// pgp_key configured, in which case attr is left empty and the encrypted value
+// is set in encrypted_<attr> along with key_fingerprint.
+//
+// Encryption is not deterministic, so an existing encrypted value is kept
+// as-is unless pgp_key has changed. This means it is only suitable for
+// secrets which never change once they have been read.
+func setPgpEncryptedAttribute(d *schema.ResourceData, attr, value, description string) error {
+	encryptedAttr := "encrypted_" + attr
+	pgpKey := d.Get("pgp_key").(string)
+
+	if pgpKey == "" || value == "" {
+		d.Set(attr, value)
+		d.Set(encryptedAttr, "")
+		if pgpKey == "" {
+			d.Set("key_fingerprint", "")
+		}
+		return nil
+	}
+
+	d.Set(attr, "")
+
+	if !d.HasChange("pgp_key") && d.Get(encryptedAttr).(string) != "" {
+		return nil
+	}
+
+	fingerprint, encrypted, err := encryptValueWithPgpKey(pgpKey, value, description)
+	if err != nil {
+		return err
+	}
+
+	d.Set("key_fingerprint", fingerprint)
+	d.Set(encryptedAttr, encrypted)
+
+	return nil
+}
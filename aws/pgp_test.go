@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/vault/helper/pgpkeys"
+)
+
+func TestEncryptValueWithPgpKey(t *testing.T) {
+	fingerprint, encrypted, err := encryptValueWithPgpKey(testPubKey1, "secret", "Test Value")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if fingerprint == "" {
+		t.Fatal("expected a key fingerprint")
+	}
+
+	decrypted, err := pgpkeys.DecryptBytes(encrypted, testPrivKey1)
+	if err != nil {
+		t.Fatalf("error decrypting value: %s", err)
+	}
+
+	if decrypted.String() != "secret" {
+		t.Fatalf("expected decrypted value %q, got %q", "secret", decrypted.String())
+	}
+}
+
+func TestEncryptValueWithPgpKey_invalidKey(t *testing.T) {
+	if _, _, err := encryptValueWithPgpKey("not a key", "secret", "Test Value"); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -425,8 +425,25 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsAmiDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).ec2conn
 
+	// If we're managing the EBS snapshots then we need to delete those too.
+	var snapshotIds []string
+	if d.Get("manage_ebs_snapshots").(bool) {
+		ebsBlockDevsSet := d.Get("ebs_block_device").(*schema.Set)
+		for _, ebsBlockDevI := range ebsBlockDevsSet.List() {
+			ebsBlockDev := ebsBlockDevI.(map[string]interface{})
+			snapshotIds = append(snapshotIds, ebsBlockDev["snapshot_id"].(string))
+		}
+	}
+
+	return deregisterAwsAmi(client, d.Id(), snapshotIds, d.Timeout(schema.TimeoutDelete))
+}
+
+// deregisterAwsAmi deregisters an AMI, deletes the given EBS snapshots which
+// backed it and waits for the AMI to be removed. Errors from DeregisterImage
+// are returned unwrapped so that callers can inspect them.
+func deregisterAwsAmi(client *ec2.EC2, id string, snapshotIds []string, timeout time.Duration) error {
 	req := &ec2.DeregisterImageInput{
-		ImageId: aws.String(d.Id()),
+		ImageId: aws.String(id),
 	}
 
 	_, err := client.DeregisterImage(req)
@@ -434,35 +451,30 @@ func resourceAwsAmiDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	// If we're managing the EBS snapshots then we need to delete those too.
-	if d.Get("manage_ebs_snapshots").(bool) {
-		errs := map[string]error{}
-		ebsBlockDevsSet := d.Get("ebs_block_device").(*schema.Set)
-		req := &ec2.DeleteSnapshotInput{}
-		for _, ebsBlockDevI := range ebsBlockDevsSet.List() {
-			ebsBlockDev := ebsBlockDevI.(map[string]interface{})
-			snapshotId := ebsBlockDev["snapshot_id"].(string)
-			if snapshotId != "" {
-				req.SnapshotId = aws.String(snapshotId)
-				_, err := client.DeleteSnapshot(req)
-				if err != nil {
-					errs[snapshotId] = err
-				}
-			}
+	errs := map[string]error{}
+	for _, snapshotId := range snapshotIds {
+		if snapshotId == "" {
+			continue
 		}
+		_, err := client.DeleteSnapshot(&ec2.DeleteSnapshotInput{
+			SnapshotId: aws.String(snapshotId),
+		})
+		if err != nil {
+			errs[snapshotId] = err
+		}
+	}
 
-		if len(errs) > 0 {
-			errParts := []string{"Errors while deleting associated EBS snapshots:"}
-			for snapshotId, err := range errs {
-				errParts = append(errParts, fmt.Sprintf("%s: %s", snapshotId, err))
-			}
-			errParts = append(errParts, "These are no longer managed by Terraform and must be deleted manually.")
-			return errors.New(strings.Join(errParts, "\n"))
+	if len(errs) > 0 {
+		errParts := []string{"Errors while deleting associated EBS snapshots:"}
+		for snapshotId, err := range errs {
+			errParts = append(errParts, fmt.Sprintf("%s: %s", snapshotId, err))
 		}
+		errParts = append(errParts, "These are no longer managed by Terraform and must be deleted manually.")
+		return errors.New(strings.Join(errParts, "\n"))
 	}
 
 	// Verify that the image is actually removed, if not we need to wait for it to be removed
-	if err := resourceAwsAmiWaitForDestroy(d.Timeout(schema.TimeoutDelete), d.Id(), client); err != nil {
+	if err := resourceAwsAmiWaitForDestroy(timeout, id, client); err != nil {
 		return err
 	}
 
@@ -0,0 +1,352 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAmiImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAmiImportCreate,
+		Read:   resourceAwsAmiImportRead,
+		Update: resourceAwsAmiImportUpdate,
+		Delete: resourceAwsAmiImportDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(AWSAMIDeleteRetryTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"architecture": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ArchitectureValuesI386,
+					ec2.ArchitectureValuesX8664,
+				}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"disk_container": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"device_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"OVA",
+								"RAW",
+								"VHD",
+								"VHDX",
+								"VMDK",
+							}, true),
+						},
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"user_bucket": ec2ImportUserBucketSchema(),
+					},
+				},
+			},
+			"hypervisor": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.HypervisorTypeXen,
+				}, false),
+			},
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AWS",
+					"BYOL",
+				}, false),
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Linux",
+					"Windows",
+				}, false),
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ebs_snapshot_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"import_task_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"root_device_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsAmiImportCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.ImportImageInput{
+		ClientToken:    aws.String(resource.UniqueId()),
+		DiskContainers: expandEc2ImageDiskContainers(d.Get("disk_container").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("architecture"); ok {
+		input.Architecture = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hypervisor"); ok {
+		input.Hypervisor = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("license_type"); ok {
+		input.LicenseType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("platform"); ok {
+		input.Platform = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_name"); ok {
+		input.RoleName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Importing AMI: %s", input)
+	output, err := conn.ImportImage(input)
+	if err != nil {
+		return fmt.Errorf("error importing AMI: %s", err)
+	}
+
+	taskID := aws.StringValue(output.ImportTaskId)
+	d.Set("import_task_id", taskID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2ImportTaskStatusActive},
+		Target:     []string{ec2ImportTaskStatusCompleted},
+		Refresh:    ec2ImportImageTaskRefreshFunc(conn, taskID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	taskRaw, err := stateConf.WaitForState()
+	if err != nil {
+		ec2CancelImportTask(conn, taskID)
+		return fmt.Errorf("error waiting for AMI import task (%s) to complete: %s", taskID, err)
+	}
+
+	task := taskRaw.(*ec2.ImportImageTask)
+	d.SetId(aws.StringValue(task.ImageId))
+
+	if _, err := resourceAwsAmiWaitForAvailable(d.Timeout(schema.TimeoutCreate), d.Id(), conn); err != nil {
+		return err
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error setting AMI (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsAmiImportRead(d, meta)
+}
+
+func resourceAwsAmiImportRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	output, err := conn.DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: []*string{aws.String(d.Id())},
+	})
+	if isAWSErr(err, "InvalidAMIID.NotFound", "") {
+		log.Printf("[WARN] AMI (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading AMI (%s): %s", d.Id(), err)
+	}
+
+	if len(output.Images) == 0 || aws.StringValue(output.Images[0].State) == ec2.ImageStateDeregistered {
+		log.Printf("[WARN] AMI (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	image := output.Images[0]
+
+	d.Set("architecture", image.Architecture)
+	d.Set("name", image.Name)
+	d.Set("root_device_name", image.RootDeviceName)
+
+	// Imported Windows AMIs report their platform; Linux AMIs do not.
+	if image.Platform != nil {
+		d.Set("platform", "Windows")
+	}
+
+	snapshotIds := make([]string, 0, len(image.BlockDeviceMappings))
+	for _, bdm := range image.BlockDeviceMappings {
+		if bdm.Ebs != nil && bdm.Ebs.SnapshotId != nil {
+			snapshotIds = append(snapshotIds, aws.StringValue(bdm.Ebs.SnapshotId))
+		}
+	}
+	if err := d.Set("ebs_snapshot_ids", snapshotIds); err != nil {
+		return fmt.Errorf("error setting ebs_snapshot_ids: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMap(image.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAmiImportUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating AMI (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsAmiImportRead(d, meta)
+}
+
+func resourceAwsAmiImportDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// The EBS snapshots backing an imported AMI were created by the import
+	// task, so they are always deleted along with the AMI.
+	snapshotIds := expandStringList(d.Get("ebs_snapshot_ids").([]interface{}))
+
+	err := deregisterAwsAmi(conn, d.Id(), aws.StringValueSlice(snapshotIds), d.Timeout(schema.TimeoutDelete))
+	if isAWSErr(err, "InvalidAMIID.NotFound", "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deregistering AMI (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func ec2ImportImageTaskRefreshFunc(conn *ec2.EC2, taskID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeImportImageTasks(&ec2.DescribeImportImageTasksInput{
+			ImportTaskIds: []*string{aws.String(taskID)},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(output.ImportImageTasks) == 0 {
+			return nil, "", nil
+		}
+
+		task := output.ImportImageTasks[0]
+		status := aws.StringValue(task.Status)
+
+		log.Printf("[DEBUG] AMI import task (%s) status: %s, progress: %s%%, message: %s",
+			taskID, status, aws.StringValue(task.Progress), aws.StringValue(task.StatusMessage))
+
+		switch status {
+		case ec2ImportTaskStatusActive, ec2ImportTaskStatusCompleted:
+			return task, status, nil
+		default:
+			return task, status, fmt.Errorf("import task %s: %s", status, aws.StringValue(task.StatusMessage))
+		}
+	}
+}
+
+func expandEc2ImageDiskContainers(l []interface{}) []*ec2.ImageDiskContainer {
+	diskContainers := make([]*ec2.ImageDiskContainer, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		diskContainer := &ec2.ImageDiskContainer{
+			UserBucket: expandEc2ImportUserBucket(m["user_bucket"].([]interface{})),
+		}
+
+		if v, ok := m["description"].(string); ok && v != "" {
+			diskContainer.Description = aws.String(v)
+		}
+
+		if v, ok := m["device_name"].(string); ok && v != "" {
+			diskContainer.DeviceName = aws.String(v)
+		}
+
+		if v, ok := m["format"].(string); ok && v != "" {
+			diskContainer.Format = aws.String(v)
+		}
+
+		if v, ok := m["snapshot_id"].(string); ok && v != "" {
+			diskContainer.SnapshotId = aws.String(v)
+		}
+
+		if v, ok := m["url"].(string); ok && v != "" {
+			diskContainer.Url = aws.String(v)
+		}
+
+		diskContainers = append(diskContainers, diskContainer)
+	}
+
+	return diskContainers
+}
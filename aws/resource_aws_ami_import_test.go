@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAmiImport_basic(t *testing.T) {
+	var image ec2.Image
+	resourceName := "aws_ami_import.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckEc2ImportDiskImage(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAmiImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAmiImportConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAmiImportExists(resourceName, &image),
+					resource.TestCheckResourceAttrSet(resourceName, "import_task_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "root_device_name"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_snapshot_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAmiImportExists(n string, image *ec2.Image) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AMI ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := conn.DescribeImages(&ec2.DescribeImagesInput{
+			ImageIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.Images) == 0 {
+			return fmt.Errorf("AMI (%s) not found", rs.Primary.ID)
+		}
+
+		*image = *output.Images[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAwsAmiImportDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ami_import" {
+			continue
+		}
+
+		output, err := conn.DescribeImages(&ec2.DescribeImagesInput{
+			ImageIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil && !isAWSErr(err, "InvalidAMIID.NotFound", "") {
+			return err
+		}
+
+		if err == nil && len(output.Images) > 0 && aws.StringValue(output.Images[0].State) != ec2.ImageStateDeregistered {
+			return fmt.Errorf("AMI (%s) still exists", rs.Primary.ID)
+		}
+
+		// The imported snapshots must be cleaned up along with the AMI.
+		snapshotID := rs.Primary.Attributes["ebs_snapshot_ids.0"]
+		snapshots, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: []*string{aws.String(snapshotID)},
+		})
+		if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(snapshots.Snapshots) > 0 {
+			return fmt.Errorf("EBS Snapshot (%s) still exists", snapshotID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsAmiImportConfig(rName string) string {
+	return testAccAwsEc2ImportRoleConfig(rName) + fmt.Sprintf(`
+resource "aws_ami_import" "test" {
+  description  = %q
+  license_type = "BYOL"
+  role_name    = "${aws_iam_role_policy.test.role}"
+
+  disk_container {
+    format = "VMDK"
+
+    user_bucket {
+      s3_bucket = %q
+      s3_key    = %q
+    }
+  }
+
+  tags {
+    Name = %q
+  }
+}
+`, rName, os.Getenv("EC2_IMPORT_DISK_IMAGE_S3_BUCKET"), os.Getenv("EC2_IMPORT_DISK_IMAGE_S3_KEY"), rName)
+}
@@ -26,6 +26,8 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 		Delete: resourceAwsDefaultNetworkAclDelete,
 		Update: resourceAwsDefaultNetworkAclUpdate,
 
+		CustomizeDiff: resourceAwsNetworkAclCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -146,6 +148,12 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 			},
 
 			"tags": tagsSchema(),
+
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -153,12 +161,15 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 func resourceAwsDefaultNetworkAclCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(d.Get("default_network_acl_id").(string))
 
-	// revoke all default and pre-existing rules on the default network acl.
+	// revoke all default and pre-existing rules on the default network acl,
+	// unless rules not declared in-line are left alone.
 	// In the UPDATE method, we'll apply only the rules in the configuration.
-	log.Printf("[DEBUG] Revoking default ingress and egress rules for Default Network ACL for %s", d.Id())
-	err := revokeAllNetworkACLEntries(d.Id(), meta)
-	if err != nil {
-		return err
+	if d.Get("exclusive").(bool) {
+		log.Printf("[DEBUG] Revoking default ingress and egress rules for Default Network ACL for %s", d.Id())
+		err := revokeAllNetworkACLEntries(d.Id(), meta)
+		if err != nil {
+			return err
+		}
 	}
 
 	return resourceAwsDefaultNetworkAclUpdate(d, meta)
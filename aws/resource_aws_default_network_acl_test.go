@@ -44,6 +44,30 @@ func TestAccAWSDefaultNetworkAcl_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSDefaultNetworkAcl_nonExclusive(t *testing.T) {
+	// With exclusive = false the AWS default rules are left alone, so we
+	// expect 5 rules: 2 hidden, 2 AWS defaults and 1 standalone Ingress rule.
+	var networkAcl ec2.NetworkAcl
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDefaultNetworkAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDefaultNetworkConfig_nonExclusive,
+				Check: resource.ComposeTestCheckFunc(
+					testAccGetAWSDefaultNetworkAcl("aws_default_network_acl.default", &networkAcl),
+					testAccCheckAWSDefaultACLAttributes(&networkAcl, []*ec2.NetworkAclEntry{}, 0, 5),
+					resource.TestCheckResourceAttr("aws_default_network_acl.default", "exclusive", "false"),
+					resource.TestCheckResourceAttr("aws_default_network_acl.default", "ingress.#", "0"),
+					resource.TestCheckResourceAttr("aws_default_network_acl.default", "egress.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDefaultNetworkAcl_basicIpv6Vpc(t *testing.T) {
 	var networkAcl ec2.NetworkAcl
 
@@ -246,6 +270,36 @@ resource "aws_default_network_acl" "default" {
 }
 `
 
+const testAccAWSDefaultNetworkConfig_nonExclusive = `
+resource "aws_vpc" "tftestvpc" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-default-network-acl-non-exclusive"
+  }
+}
+
+resource "aws_default_network_acl" "default" {
+  default_network_acl_id = "${aws_vpc.tftestvpc.default_network_acl_id}"
+  exclusive              = false
+
+  tags {
+    Name = "tf-acc-default-acl-non-exclusive"
+  }
+}
+
+resource "aws_network_acl_rule" "test" {
+  network_acl_id = "${aws_vpc.tftestvpc.default_network_acl_id}"
+  rule_number    = 200
+  egress         = false
+  protocol       = "tcp"
+  rule_action    = "allow"
+  cidr_block     = "10.2.0.0/16"
+  from_port      = 443
+  to_port        = 443
+}
+`
+
 const testAccAWSDefaultNetworkConfig_includingIpv6Rule = `
 resource "aws_vpc" "tftestvpc" {
   cidr_block = "10.1.0.0/16"
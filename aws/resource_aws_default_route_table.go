@@ -16,6 +16,8 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 		Update: resourceAwsRouteTableUpdate,
 		Delete: resourceAwsDefaultRouteTableDelete,
 
+		CustomizeDiff: resourceAwsRouteTableCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"default_route_table_id": {
 				Type:     schema.TypeString,
@@ -86,6 +88,12 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 			},
 
 			"tags": tagsSchema(),
+
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -108,11 +116,14 @@ func resourceAwsDefaultRouteTableCreate(d *schema.ResourceData, meta interface{}
 
 	d.Set("vpc_id", rt.VpcId)
 
-	// revoke all default and pre-existing routes on the default route table.
+	// revoke all default and pre-existing routes on the default route table,
+	// unless routes not declared in-line are left alone.
 	// In the UPDATE method, we'll apply only the rules in the configuration.
-	log.Printf("[DEBUG] Revoking default routes for Default Route Table for %s", d.Id())
-	if err := revokeAllRouteTableRules(d.Id(), meta); err != nil {
-		return err
+	if d.Get("exclusive").(bool) {
+		log.Printf("[DEBUG] Revoking default routes for Default Route Table for %s", d.Id())
+		if err := revokeAllRouteTableRules(d.Id(), meta); err != nil {
+			return err
+		}
 	}
 
 	return resourceAwsRouteTableUpdate(d, meta)
@@ -31,6 +31,27 @@ func TestAccAWSDefaultRouteTable_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSDefaultRouteTable_nonExclusive(t *testing.T) {
+	var v ec2.RouteTable
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDefaultRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultRouteTableConfigInlineAndStandaloneRoutes,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists("aws_default_route_table.test", &v),
+					resource.TestCheckResourceAttr("aws_default_route_table.test", "exclusive", "false"),
+					resource.TestCheckResourceAttr("aws_default_route_table.test", "route.#", "1"),
+					resource.TestCheckResourceAttrSet("aws_route.test", "state"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDefaultRouteTable_swap(t *testing.T) {
 	var v ec2.RouteTable
 
@@ -154,6 +175,36 @@ resource "aws_internet_gateway" "gw" {
   }
 }`
 
+const testAccDefaultRouteTableConfigInlineAndStandaloneRoutes = `
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-default-route-table-inline-and-standalone-routes"
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+}
+
+resource "aws_default_route_table" "test" {
+  default_route_table_id = "${aws_vpc.test.default_route_table_id}"
+  exclusive              = false
+
+  route {
+    cidr_block = "10.2.0.0/16"
+    gateway_id = "${aws_internet_gateway.test.id}"
+  }
+}
+
+resource "aws_route" "test" {
+  route_table_id         = "${aws_vpc.test.default_route_table_id}"
+  destination_cidr_block = "10.3.0.0/16"
+  gateway_id             = "${aws_internet_gateway.test.id}"
+}
+`
+
 const testAccDefaultRouteTable_change = `
 provider "aws" {
   region = "us-west-2"
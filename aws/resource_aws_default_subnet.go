@@ -3,9 +3,11 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -46,6 +48,11 @@ func resourceAwsDefaultSubnet() *schema.Resource {
 		Type:     schema.TypeBool,
 		Computed: true,
 	}
+	dsubnet.Schema["force_destroy"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
 
 	return dsubnet
 }
@@ -66,15 +73,43 @@ func resourceAwsDefaultSubnetCreate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
-	if len(resp.Subnets) != 1 || resp.Subnets[0] == nil {
-		return fmt.Errorf("Default subnet not found")
+	if len(resp.Subnets) > 1 {
+		return fmt.Errorf("Multiple default subnets found in %s", d.Get("availability_zone").(string))
+	}
+
+	if len(resp.Subnets) == 1 && resp.Subnets[0] != nil {
+		d.SetId(aws.StringValue(resp.Subnets[0].SubnetId))
+		return resourceAwsSubnetUpdate(d, meta)
+	}
+
+	log.Printf("[DEBUG] No default subnet found in %s, creating one", d.Get("availability_zone").(string))
+	createResp, err := conn.CreateDefaultSubnet(&ec2.CreateDefaultSubnetInput{
+		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Default Subnet in %s: %s", d.Get("availability_zone").(string), err)
+	}
+
+	d.SetId(aws.StringValue(createResp.Subnet.SubnetId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"available"},
+		Refresh: SubnetStateRefreshFunc(conn, d.Id()),
+		Timeout: 10 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Default Subnet (%s) to become available: %s", d.Id(), err)
 	}
 
-	d.SetId(aws.StringValue(resp.Subnets[0].SubnetId))
 	return resourceAwsSubnetUpdate(d, meta)
 }
 
 func resourceAwsDefaultSubnetDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[WARN] Cannot destroy Default Subnet. Terraform will remove this resource from the state file, however resources may remain.")
-	return nil
+	if !d.Get("force_destroy").(bool) {
+		log.Printf("[WARN] Cannot destroy Default Subnet. Terraform will remove this resource from the state file, however resources may remain.")
+		return nil
+	}
+
+	return resourceAwsSubnetDelete(d, meta)
 }
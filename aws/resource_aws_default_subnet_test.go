@@ -1,8 +1,10 @@
 package aws
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -78,6 +80,54 @@ func TestAccAWSDefaultSubnet_publicIp(t *testing.T) {
 	})
 }
 
+// TestAccAWSDefaultSubnet_forceDestroy deletes the default subnet of an
+// availability zone, which is recreated the next time the test is run.
+func TestAccAWSDefaultSubnet_forceDestroy(t *testing.T) {
+	var v ec2.Subnet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDefaultVpcForceDestroy(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDefaultSubnetDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDefaultSubnetConfigForceDestroy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists("aws_default_subnet.foo", &v),
+					resource.TestCheckResourceAttr("aws_default_subnet.foo", "availability_zone", "us-west-2a"),
+					resource.TestCheckResourceAttr("aws_default_subnet.foo", "force_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDefaultSubnetDestroyed(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_default_subnet" {
+			continue
+		}
+
+		resp, err := conn.DescribeSubnets(&ec2.DescribeSubnetsInput{
+			Filters: buildEC2AttributeFilterList(map[string]string{
+				"availabilityZone": rs.Primary.Attributes["availability_zone"],
+				"defaultForAz":     "true",
+			}),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Subnets) > 0 {
+			return fmt.Errorf("Default Subnet (%s) still exists", aws.StringValue(resp.Subnets[0].SubnetId))
+		}
+	}
+
+	return nil
+}
+
 func testAccCheckAWSDefaultSubnetDestroy(s *terraform.State) error {
 	// We expect subnet to still exist
 	return nil
@@ -111,3 +161,10 @@ resource "aws_default_subnet" "foo" {
   }
 }
 `
+
+const testAccAWSDefaultSubnetConfigForceDestroy = `
+resource "aws_default_subnet" "foo" {
+  availability_zone = "us-west-2a"
+  force_destroy     = true
+}
+`
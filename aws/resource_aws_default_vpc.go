@@ -3,9 +3,11 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -30,6 +32,11 @@ func resourceAwsDefaultVpc() *schema.Resource {
 		Type:     schema.TypeBool,
 		Computed: true,
 	}
+	dvpc.Schema["force_destroy"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
 
 	return dvpc
 }
@@ -50,16 +57,89 @@ func resourceAwsDefaultVpcCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if resp.Vpcs == nil || len(resp.Vpcs) == 0 {
-		return fmt.Errorf("No default VPC found in this region.")
+	if len(resp.Vpcs) > 0 {
+		d.SetId(aws.StringValue(resp.Vpcs[0].VpcId))
+
+		return resourceAwsVpcUpdate(d, meta)
 	}
 
-	d.SetId(aws.StringValue(resp.Vpcs[0].VpcId))
+	log.Printf("[DEBUG] No default VPC found in this region, creating one")
+	createResp, err := conn.CreateDefaultVpc(&ec2.CreateDefaultVpcInput{})
+	if err != nil {
+		return fmt.Errorf("error creating Default VPC: %s", err)
+	}
+
+	d.SetId(aws.StringValue(createResp.Vpc.VpcId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"available"},
+		Refresh: VPCStateRefreshFunc(conn, d.Id()),
+		Timeout: 10 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Default VPC (%s) to become available: %s", d.Id(), err)
+	}
 
 	return resourceAwsVpcUpdate(d, meta)
 }
 
 func resourceAwsDefaultVpcDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[WARN] Cannot destroy Default VPC. Terraform will remove this resource from the state file, however resources may remain.")
-	return nil
+	if !d.Get("force_destroy").(bool) {
+		log.Printf("[WARN] Cannot destroy Default VPC. Terraform will remove this resource from the state file, however resources may remain.")
+		return nil
+	}
+
+	conn := meta.(*AWSClient).ec2conn
+
+	// A Default VPC comes with a default subnet in each availability zone and
+	// an attached internet gateway, which must be deleted first.
+	subnetsResp, err := conn.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"vpc-id":       d.Id(),
+			"defaultForAz": "true",
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Default Subnets of Default VPC (%s): %s", d.Id(), err)
+	}
+
+	for _, subnet := range subnetsResp.Subnets {
+		log.Printf("[DEBUG] Deleting Default Subnet (%s) of Default VPC (%s)", aws.StringValue(subnet.SubnetId), d.Id())
+		if err := deleteAwsSubnet(conn, aws.StringValue(subnet.SubnetId)); err != nil {
+			return err
+		}
+	}
+
+	igwResp, err := conn.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"attachment.vpc-id": d.Id(),
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Internet Gateways of Default VPC (%s): %s", d.Id(), err)
+	}
+
+	for _, igw := range igwResp.InternetGateways {
+		igwID := aws.StringValue(igw.InternetGatewayId)
+
+		log.Printf("[DEBUG] Detaching Internet Gateway (%s) from Default VPC (%s)", igwID, d.Id())
+		_, err := conn.DetachInternetGateway(&ec2.DetachInternetGatewayInput{
+			InternetGatewayId: aws.String(igwID),
+			VpcId:             aws.String(d.Id()),
+		})
+		if err != nil && !isAWSErr(err, "Gateway.NotAttached", "") {
+			return fmt.Errorf("error detaching Internet Gateway (%s) from Default VPC (%s): %s", igwID, d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Deleting Internet Gateway (%s) of Default VPC (%s)", igwID, d.Id())
+		_, err = conn.DeleteInternetGateway(&ec2.DeleteInternetGatewayInput{
+			InternetGatewayId: aws.String(igwID),
+		})
+		if err != nil && !isAWSErr(err, "InvalidInternetGatewayID.NotFound", "") {
+			return fmt.Errorf("error deleting Internet Gateway (%s) of Default VPC (%s): %s", igwID, d.Id(), err)
+		}
+	}
+
+	return resourceAwsVpcDelete(d, meta)
 }
@@ -3,9 +3,11 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -30,6 +32,11 @@ func resourceAwsDefaultVpcDhcpOptions() *schema.Resource {
 		Type:     schema.TypeString,
 		Computed: true,
 	}
+	dvpc.Schema["force_destroy"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
 
 	return dvpc
 }
@@ -70,11 +77,48 @@ func resourceAwsDefaultVpcDhcpOptionsCreate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	if len(resp.DhcpOptions) != 1 || resp.DhcpOptions[0] == nil {
-		return fmt.Errorf("Default DHCP Options Set not found")
+	if len(resp.DhcpOptions) > 1 {
+		return fmt.Errorf("Multiple default DHCP Options Sets found")
 	}
 
-	d.SetId(aws.StringValue(resp.DhcpOptions[0].DhcpOptionsId))
+	if len(resp.DhcpOptions) == 1 && resp.DhcpOptions[0] != nil {
+		d.SetId(aws.StringValue(resp.DhcpOptions[0].DhcpOptionsId))
+	} else {
+		// There is no API to recreate the default DHCP Options Set, so create
+		// one with the same options.
+		log.Printf("[DEBUG] No default DHCP Options Set found, creating one")
+		createResp, err := conn.CreateDhcpOptions(&ec2.CreateDhcpOptionsInput{
+			DhcpConfigurations: []*ec2.NewDhcpConfiguration{
+				{
+					Key:    aws.String("domain-name"),
+					Values: aws.StringSlice([]string{domainName}),
+				},
+				{
+					Key:    aws.String("domain-name-servers"),
+					Values: aws.StringSlice([]string{"AmazonProvidedDNS"}),
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error creating Default DHCP Options Set: %s", err)
+		}
+
+		d.SetId(aws.StringValue(createResp.DhcpOptions.DhcpOptionsId))
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"pending"},
+			Target:  []string{"created"},
+			Refresh: resourceDHCPOptionsStateRefreshFunc(conn, d.Id()),
+			Timeout: 5 * time.Minute,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for Default DHCP Options Set (%s) to become available: %s", d.Id(), err)
+		}
+
+		if err := resourceAwsDefaultVpcDhcpOptionsAssociate(conn, d.Id()); err != nil {
+			return err
+		}
+	}
 
 	if err := resourceAwsVpcDhcpOptionsUpdate(d, meta); err != nil {
 		return err
@@ -83,7 +127,52 @@ func resourceAwsDefaultVpcDhcpOptionsCreate(d *schema.ResourceData, meta interfa
 	return resourceAwsVpcDhcpOptionsRead(d, meta)
 }
 
-func resourceAwsDefaultVpcDhcpOptionsDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[WARN] Cannot destroy Default DHCP Options Set. Terraform will remove this resource from the state file, however resources may remain.")
+// resourceAwsDefaultVpcDhcpOptionsAssociate associates a recreated default
+// DHCP Options Set with the default VPC, which was left without one when the
+// original set was disassociated to delete it. Default VPCs associated with
+// another DHCP Options Set are left as they are.
+func resourceAwsDefaultVpcDhcpOptionsAssociate(conn *ec2.EC2, dhcpOptionsID string) error {
+	resp, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("isDefault"),
+				Values: aws.StringSlice([]string{"true"}),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Default VPC: %s", err)
+	}
+
+	if len(resp.Vpcs) == 0 || resp.Vpcs[0] == nil {
+		log.Printf("[DEBUG] No default VPC found, not associating Default DHCP Options Set (%s)", dhcpOptionsID)
+		return nil
+	}
+
+	vpc := resp.Vpcs[0]
+	vpcID := aws.StringValue(vpc.VpcId)
+	if v := aws.StringValue(vpc.DhcpOptionsId); v != "" && v != "default" {
+		log.Printf("[DEBUG] Default VPC (%s) is associated with DHCP Options Set (%s), not associating Default DHCP Options Set (%s)", vpcID, v, dhcpOptionsID)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Associating Default DHCP Options Set (%s) with Default VPC (%s)", dhcpOptionsID, vpcID)
+	_, err = conn.AssociateDhcpOptions(&ec2.AssociateDhcpOptionsInput{
+		DhcpOptionsId: aws.String(dhcpOptionsID),
+		VpcId:         aws.String(vpcID),
+	})
+	if err != nil {
+		return fmt.Errorf("error associating Default DHCP Options Set (%s) with Default VPC (%s): %s", dhcpOptionsID, vpcID, err)
+	}
+
 	return nil
 }
+
+func resourceAwsDefaultVpcDhcpOptionsDelete(d *schema.ResourceData, meta interface{}) error {
+	if !d.Get("force_destroy").(bool) {
+		log.Printf("[WARN] Cannot destroy Default DHCP Options Set. Terraform will remove this resource from the state file, however resources may remain.")
+		return nil
+	}
+
+	return resourceAwsVpcDhcpOptionsDelete(d, meta)
+}
@@ -1,8 +1,11 @@
 package aws
 
 import (
+	"fmt"
+	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -41,6 +44,53 @@ func TestAccAWSDefaultVpc_basic(t *testing.T) {
 	})
 }
 
+// TestAccAWSDefaultVpc_forceDestroy deletes the default VPC of the region,
+// which is recreated the next time the test is run.
+func TestAccAWSDefaultVpc_forceDestroy(t *testing.T) {
+	var vpc ec2.Vpc
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDefaultVpcForceDestroy(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDefaultVpcDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDefaultVpcConfigForceDestroy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("aws_default_vpc.foo", &vpc),
+					resource.TestCheckResourceAttrSet("aws_default_vpc.foo", "main_route_table_id"),
+					resource.TestCheckResourceAttr("aws_default_vpc.foo", "force_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSDefaultVpcForceDestroy(t *testing.T) {
+	if os.Getenv("TF_ACC_DEFAULT_VPC_FORCE_DESTROY") == "" {
+		t.Skip("Environment variable TF_ACC_DEFAULT_VPC_FORCE_DESTROY is not set, skipping tests deleting default VPC resources")
+	}
+}
+
+func testAccCheckAWSDefaultVpcDestroyed(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"isDefault": "true",
+		}),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Vpcs) > 0 {
+		return fmt.Errorf("Default VPC (%s) still exists", aws.StringValue(resp.Vpcs[0].VpcId))
+	}
+
+	return nil
+}
+
 func testAccCheckAWSDefaultVpcDestroy(s *terraform.State) error {
 	// We expect VPC to still exist
 	return nil
@@ -57,3 +107,9 @@ resource "aws_default_vpc" "foo" {
 	}
 }
 `
+
+const testAccAWSDefaultVpcConfigForceDestroy = `
+resource "aws_default_vpc" "foo" {
+  force_destroy = true
+}
+`
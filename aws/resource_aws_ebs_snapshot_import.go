@@ -0,0 +1,305 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEbsSnapshotImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEbsSnapshotImportCreate,
+		Read:   resourceAwsEbsSnapshotImportRead,
+		Update: resourceAwsEbsSnapshotImportUpdate,
+		Delete: resourceAwsEbsSnapshotDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"disk_container": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"RAW",
+								"VHD",
+								"VMDK",
+							}, true),
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"user_bucket": ec2ImportUserBucketSchema(),
+					},
+				},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"data_encryption_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"import_task_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEbsSnapshotImportCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.ImportSnapshotInput{
+		ClientToken:   aws.String(resource.UniqueId()),
+		DiskContainer: expandEc2SnapshotDiskContainer(d.Get("disk_container").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_name"); ok {
+		input.RoleName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Importing EBS Snapshot: %s", input)
+	output, err := conn.ImportSnapshot(input)
+	if err != nil {
+		return fmt.Errorf("error importing EBS Snapshot: %s", err)
+	}
+
+	taskID := aws.StringValue(output.ImportTaskId)
+	d.Set("import_task_id", taskID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2ImportTaskStatusActive},
+		Target:     []string{ec2ImportTaskStatusCompleted},
+		Refresh:    ec2ImportSnapshotTaskRefreshFunc(conn, taskID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	taskRaw, err := stateConf.WaitForState()
+	if err != nil {
+		ec2CancelImportTask(conn, taskID)
+		return fmt.Errorf("error waiting for EBS Snapshot import task (%s) to complete: %s", taskID, err)
+	}
+
+	task := taskRaw.(*ec2.ImportSnapshotTask)
+	d.SetId(aws.StringValue(task.SnapshotTaskDetail.SnapshotId))
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error setting EBS Snapshot (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEbsSnapshotImportRead(d, meta)
+}
+
+func resourceAwsEbsSnapshotImportRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	output, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+		SnapshotIds: []*string{aws.String(d.Id())},
+	})
+	if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+		log.Printf("[WARN] EBS Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading EBS Snapshot (%s): %s", d.Id(), err)
+	}
+
+	if len(output.Snapshots) == 0 {
+		log.Printf("[WARN] EBS Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	snapshot := output.Snapshots[0]
+
+	d.Set("data_encryption_key_id", snapshot.DataEncryptionKeyId)
+	d.Set("encrypted", snapshot.Encrypted)
+	d.Set("kms_key_id", snapshot.KmsKeyId)
+	d.Set("owner_alias", snapshot.OwnerAlias)
+	d.Set("owner_id", snapshot.OwnerId)
+	d.Set("volume_size", snapshot.VolumeSize)
+
+	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEbsSnapshotImportUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EBS Snapshot (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEbsSnapshotImportRead(d, meta)
+}
+
+const (
+	ec2ImportTaskStatusActive    = "active"
+	ec2ImportTaskStatusCompleted = "completed"
+)
+
+// ec2CancelImportTask cancels an import task which is not known to have
+// completed, so that it doesn't go on to create a snapshot or image which is
+// not in the Terraform state. Tasks which have already failed can't be
+// cancelled, so errors are only logged.
+func ec2CancelImportTask(conn *ec2.EC2, taskID string) {
+	input := &ec2.CancelImportTaskInput{
+		CancelReason: aws.String("Terraform failed waiting for the import task to complete"),
+		ImportTaskId: aws.String(taskID),
+	}
+
+	log.Printf("[DEBUG] Cancelling EC2 import task: %s", input)
+	if _, err := conn.CancelImportTask(input); err != nil {
+		log.Printf("[WARN] Error cancelling EC2 import task (%s): %s", taskID, err)
+	}
+}
+
+func ec2ImportSnapshotTaskRefreshFunc(conn *ec2.EC2, taskID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeImportSnapshotTasks(&ec2.DescribeImportSnapshotTasksInput{
+			ImportTaskIds: []*string{aws.String(taskID)},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(output.ImportSnapshotTasks) == 0 || output.ImportSnapshotTasks[0].SnapshotTaskDetail == nil {
+			return nil, "", nil
+		}
+
+		task := output.ImportSnapshotTasks[0]
+		detail := task.SnapshotTaskDetail
+		status := aws.StringValue(detail.Status)
+
+		log.Printf("[DEBUG] EBS Snapshot import task (%s) status: %s, progress: %s%%, message: %s",
+			taskID, status, aws.StringValue(detail.Progress), aws.StringValue(detail.StatusMessage))
+
+		switch status {
+		case ec2ImportTaskStatusActive, ec2ImportTaskStatusCompleted:
+			return task, status, nil
+		default:
+			return task, status, fmt.Errorf("import task %s: %s", status, aws.StringValue(detail.StatusMessage))
+		}
+	}
+}
+
+func ec2ImportUserBucketSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"s3_bucket": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"s3_key": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func expandEc2ImportUserBucket(l []interface{}) *ec2.UserBucket {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &ec2.UserBucket{
+		S3Bucket: aws.String(m["s3_bucket"].(string)),
+		S3Key:    aws.String(m["s3_key"].(string)),
+	}
+}
+
+func expandEc2SnapshotDiskContainer(l []interface{}) *ec2.SnapshotDiskContainer {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	diskContainer := &ec2.SnapshotDiskContainer{
+		Format:     aws.String(m["format"].(string)),
+		UserBucket: expandEc2ImportUserBucket(m["user_bucket"].([]interface{})),
+	}
+
+	if v, ok := m["description"].(string); ok && v != "" {
+		diskContainer.Description = aws.String(v)
+	}
+
+	if v, ok := m["url"].(string); ok && v != "" {
+		diskContainer.Url = aws.String(v)
+	}
+
+	return diskContainer
+}
@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// testAccPreCheckEc2ImportDiskImage skips tests which require an existing
+// VMDK disk image in S3 to import.
+func testAccPreCheckEc2ImportDiskImage(t *testing.T) {
+	if os.Getenv("EC2_IMPORT_DISK_IMAGE_S3_BUCKET") == "" || os.Getenv("EC2_IMPORT_DISK_IMAGE_S3_KEY") == "" {
+		t.Skip("Environment variables EC2_IMPORT_DISK_IMAGE_S3_BUCKET and EC2_IMPORT_DISK_IMAGE_S3_KEY are not set")
+	}
+}
+
+func TestAccAWSEbsSnapshotImport_basic(t *testing.T) {
+	var snapshot ec2.Snapshot
+	resourceName := "aws_ebs_snapshot_import.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckEc2ImportDiskImage(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsEbsSnapshotImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsSnapshotImportConfig(rName, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEbsSnapshotImportExists(resourceName, &snapshot),
+					resource.TestCheckResourceAttrSet(resourceName, "import_task_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "volume_size"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "foo"),
+				),
+			},
+			{
+				Config: testAccAwsEbsSnapshotImportConfig(rName, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEbsSnapshotImportExists(resourceName, &snapshot),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEbsSnapshotImportExists(n string, snapshot *ec2.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EBS Snapshot ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.Snapshots) == 0 {
+			return fmt.Errorf("EBS Snapshot (%s) not found", rs.Primary.ID)
+		}
+
+		*snapshot = *output.Snapshots[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAwsEbsSnapshotImportDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ebs_snapshot_import" {
+			continue
+		}
+
+		output, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(output.Snapshots) > 0 {
+			return fmt.Errorf("EBS Snapshot (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testAccAwsEc2ImportRoleConfig is the VM Import service role with access to
+// the disk image to import.
+func testAccAwsEc2ImportRoleConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "vmie.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole",
+      "Condition": {
+        "StringEquals": {
+          "sts:ExternalId": "vmimport"
+        }
+      }
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetBucketLocation",
+        "s3:GetObject",
+        "s3:ListBucket"
+      ],
+      "Resource": [
+        "arn:aws:s3:::%[2]s",
+        "arn:aws:s3:::%[2]s/*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:ModifySnapshotAttribute",
+        "ec2:CopySnapshot",
+        "ec2:RegisterImage",
+        "ec2:Describe*"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`, rName, os.Getenv("EC2_IMPORT_DISK_IMAGE_S3_BUCKET"))
+}
+
+func testAccAwsEbsSnapshotImportConfig(rName, tagValue string) string {
+	return testAccAwsEc2ImportRoleConfig(rName) + fmt.Sprintf(`
+resource "aws_ebs_snapshot_import" "test" {
+  description = %q
+  role_name   = "${aws_iam_role_policy.test.role}"
+
+  disk_container {
+    format = "VMDK"
+
+    user_bucket {
+      s3_bucket = %q
+      s3_key    = %q
+    }
+  }
+
+  tags {
+    Name = %q
+  }
+}
+`, rName, os.Getenv("EC2_IMPORT_DISK_IMAGE_S3_BUCKET"), os.Getenv("EC2_IMPORT_DISK_IMAGE_S3_KEY"), tagValue)
+}
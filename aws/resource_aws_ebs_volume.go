@@ -226,6 +226,31 @@ func volumeStateRefreshFunc(conn *ec2.EC2, volumeID string) resource.StateRefres
 	}
 }
 
+// ebsVolumeModificationStateRefreshFunc returns a resource.StateRefreshFunc
+// that is used to watch the most recent modification of a Volume.
+func ebsVolumeModificationStateRefreshFunc(conn *ec2.EC2, volumeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeVolumesModifications(&ec2.DescribeVolumesModificationsInput{
+			VolumeIds: []*string{aws.String(volumeID)},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp == nil || len(resp.VolumesModifications) == 0 {
+			return nil, "", nil
+		}
+
+		m := resp.VolumesModifications[0]
+		state := aws.StringValue(m.ModificationState)
+		if state == ec2.VolumeModificationStateFailed {
+			return m, state, fmt.Errorf("%s", aws.StringValue(m.StatusMessage))
+		}
+
+		return m, state, nil
+	}
+}
+
 func resourceAwsEbsVolumeRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
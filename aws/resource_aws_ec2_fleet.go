@@ -0,0 +1,835 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2Fleet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2FleetCreate,
+		Read:   resourceAwsEc2FleetRead,
+		Update: resourceAwsEc2FleetUpdate,
+		Delete: resourceAwsEc2FleetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsEc2FleetCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"excess_capacity_termination_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.FleetExcessCapacityTerminationPolicyTermination,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.FleetExcessCapacityTerminationPolicyNoTermination,
+					ec2.FleetExcessCapacityTerminationPolicyTermination,
+				}, false),
+			},
+			"fulfilled_capacity": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"fulfilled_on_demand_capacity": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"launch_template_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"launch_template_specification": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"launch_template_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"launch_template_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"override": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 50,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"instance_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"max_price": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"priority": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+										ForceNew: true,
+									},
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"weighted_capacity": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"on_demand_options": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				ForceNew:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allocation_strategy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  ec2.FleetOnDemandAllocationStrategyLowestPrice,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.FleetOnDemandAllocationStrategyLowestPrice,
+								ec2.FleetOnDemandAllocationStrategyPrioritized,
+							}, false),
+						},
+					},
+				},
+			},
+			"replace_unhealthy_instances": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"spot_options": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				ForceNew:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allocation_strategy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  ec2.SpotAllocationStrategyLowestPrice,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.SpotAllocationStrategyDiversified,
+								ec2.SpotAllocationStrategyLowestPrice,
+							}, false),
+						},
+						"instance_interruption_behavior": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  ec2.SpotInstanceInterruptionBehaviorTerminate,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.SpotInstanceInterruptionBehaviorHibernate,
+								ec2.SpotInstanceInterruptionBehaviorStop,
+								ec2.SpotInstanceInterruptionBehaviorTerminate,
+							}, false),
+						},
+						"instance_pools_to_use_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+			"target_capacity_specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_target_capacity_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.DefaultTargetCapacityTypeOnDemand,
+								ec2.DefaultTargetCapacityTypeSpot,
+							}, false),
+						},
+						"on_demand_target_capacity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"spot_target_capacity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"total_target_capacity": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"terminate_instances": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"terminate_instances_with_expiration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.FleetTypeMaintain,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.FleetTypeMaintain,
+					ec2.FleetTypeRequest,
+				}, false),
+			},
+			"wait_for_fulfillment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2FleetCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	// Only fleets of type maintain can be modified in place
+	if diff.Id() == "" || diff.Get("type").(string) == ec2.FleetTypeMaintain {
+		return nil
+	}
+
+	if diff.HasChange("target_capacity_specification") {
+		if err := diff.ForceNew("target_capacity_specification"); err != nil {
+			return err
+		}
+	}
+
+	if diff.HasChange("excess_capacity_termination_policy") {
+		if err := diff.ForceNew("excess_capacity_termination_policy"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsEc2FleetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	launchTemplateConfigs, err := expandEc2FleetLaunchTemplateConfigRequests(d.Get("launch_template_config").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.CreateFleetInput{
+		ExcessCapacityTerminationPolicy:  aws.String(d.Get("excess_capacity_termination_policy").(string)),
+		LaunchTemplateConfigs:            launchTemplateConfigs,
+		OnDemandOptions:                  expandEc2OnDemandOptionsRequest(d.Get("on_demand_options").([]interface{})),
+		ReplaceUnhealthyInstances:        aws.Bool(d.Get("replace_unhealthy_instances").(bool)),
+		SpotOptions:                      expandEc2SpotOptionsRequest(d.Get("spot_options").([]interface{})),
+		TargetCapacitySpecification:      expandEc2TargetCapacitySpecificationRequest(d.Get("target_capacity_specification").([]interface{})),
+		TerminateInstancesWithExpiration: aws.Bool(d.Get("terminate_instances_with_expiration").(bool)),
+		Type:                             aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.TagSpecifications = []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String("fleet"),
+				Tags:         tagsFromMap(v.(map[string]interface{})),
+			},
+		}
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Fleet: %s", input)
+	output, err := conn.CreateFleet(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Fleet: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.FleetId))
+
+	if err := waitForEc2FleetActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if d.Get("wait_for_fulfillment").(bool) {
+		if err := waitForEc2FleetFulfillment(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsEc2FleetRead(d, meta)
+}
+
+func resourceAwsEc2FleetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	fleet, err := describeEc2Fleet(conn, d.Id())
+	if isAWSErr(err, "InvalidFleetId.NotFound", "") {
+		log.Printf("[WARN] EC2 Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Fleet (%s): %s", d.Id(), err)
+	}
+
+	if fleet == nil {
+		log.Printf("[WARN] EC2 Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(fleet.FleetState) {
+	case ec2.FleetStateCodeDeleted, ec2.FleetStateCodeDeletedRunning, ec2.FleetStateCodeDeletedTerminating:
+		log.Printf("[WARN] EC2 Fleet (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(fleet.FleetState))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("excess_capacity_termination_policy", fleet.ExcessCapacityTerminationPolicy)
+	d.Set("fulfilled_capacity", fleet.FulfilledCapacity)
+	d.Set("fulfilled_on_demand_capacity", fleet.FulfilledOnDemandCapacity)
+
+	if err := d.Set("launch_template_config", flattenEc2FleetLaunchTemplateConfigs(fleet.LaunchTemplateConfigs)); err != nil {
+		return fmt.Errorf("error setting launch_template_config: %s", err)
+	}
+
+	if err := d.Set("on_demand_options", flattenEc2OnDemandOptions(fleet.OnDemandOptions)); err != nil {
+		return fmt.Errorf("error setting on_demand_options: %s", err)
+	}
+
+	d.Set("replace_unhealthy_instances", fleet.ReplaceUnhealthyInstances)
+
+	if err := d.Set("spot_options", flattenEc2SpotOptions(fleet.SpotOptions)); err != nil {
+		return fmt.Errorf("error setting spot_options: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMap(fleet.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("target_capacity_specification", flattenEc2TargetCapacitySpecification(fleet.TargetCapacitySpecification)); err != nil {
+		return fmt.Errorf("error setting target_capacity_specification: %s", err)
+	}
+
+	d.Set("terminate_instances_with_expiration", fleet.TerminateInstancesWithExpiration)
+	d.Set("type", fleet.Type)
+
+	return nil
+}
+
+func resourceAwsEc2FleetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	d.Partial(true)
+
+	if d.HasChange("excess_capacity_termination_policy") || d.HasChange("target_capacity_specification") {
+		input := &ec2.ModifyFleetInput{
+			ExcessCapacityTerminationPolicy: aws.String(d.Get("excess_capacity_termination_policy").(string)),
+			FleetId:                         aws.String(d.Id()),
+			TargetCapacitySpecification:     expandEc2TargetCapacitySpecificationRequest(d.Get("target_capacity_specification").([]interface{})),
+		}
+
+		// DefaultTargetCapacityType cannot be specified when modifying
+		input.TargetCapacitySpecification.DefaultTargetCapacityType = nil
+
+		log.Printf("[DEBUG] Modifying EC2 Fleet: %s", input)
+		if _, err := conn.ModifyFleet(input); err != nil {
+			return fmt.Errorf("error modifying EC2 Fleet (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForEc2FleetActive(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+
+		if d.Get("wait_for_fulfillment").(bool) {
+			if err := waitForEc2FleetFulfillment(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+
+		d.SetPartial("excess_capacity_termination_policy")
+		d.SetPartial("target_capacity_specification")
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Fleet (%s) tags: %s", d.Id(), err)
+	}
+	d.SetPartial("tags")
+
+	d.Partial(false)
+
+	return resourceAwsEc2FleetRead(d, meta)
+}
+
+func resourceAwsEc2FleetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	terminateInstances := d.Get("terminate_instances").(bool)
+
+	input := &ec2.DeleteFleetsInput{
+		FleetIds:           []*string{aws.String(d.Id())},
+		TerminateInstances: aws.Bool(terminateInstances),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Fleet: %s", input)
+	output, err := conn.DeleteFleets(input)
+	if isAWSErr(err, "InvalidFleetId.NotFound", "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Fleet (%s): %s", d.Id(), err)
+	}
+
+	for _, item := range output.UnsuccessfulFleetDeletions {
+		if aws.StringValue(item.FleetId) != d.Id() || item.Error == nil {
+			continue
+		}
+		if aws.StringValue(item.Error.Code) == ec2.DeleteFleetErrorCodeFleetIdDoesNotExist {
+			return nil
+		}
+		return fmt.Errorf("error deleting EC2 Fleet (%s): %s: %s", d.Id(), aws.StringValue(item.Error.Code), aws.StringValue(item.Error.Message))
+	}
+
+	// Without termination the fleet remains in deleted-running
+	target := []string{ec2.FleetStateCodeDeleted, ec2.FleetStateCodeDeletedRunning}
+	if terminateInstances {
+		target = []string{ec2.FleetStateCodeDeleted}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.FleetStateCodeActive,
+			ec2.FleetStateCodeDeletedTerminating,
+			ec2.FleetStateCodeModifying,
+			ec2.FleetStateCodeSubmitted,
+		},
+		Target:     target,
+		Refresh:    ec2FleetRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Fleet (%s) to be deleted", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for EC2 Fleet (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeEc2Fleet(conn *ec2.EC2, fleetID string) (*ec2.FleetData, error) {
+	output, err := conn.DescribeFleets(&ec2.DescribeFleetsInput{
+		FleetIds: []*string{aws.String(fleetID)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fleet := range output.Fleets {
+		if aws.StringValue(fleet.FleetId) == fleetID {
+			return fleet, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2FleetRefreshFunc(conn *ec2.EC2, fleetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fleet, err := describeEc2Fleet(conn, fleetID)
+		if isAWSErr(err, "InvalidFleetId.NotFound", "") {
+			return "", ec2.FleetStateCodeDeleted, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if fleet == nil {
+			return "", ec2.FleetStateCodeDeleted, nil
+		}
+
+		return fleet, aws.StringValue(fleet.FleetState), nil
+	}
+}
+
+func waitForEc2FleetActive(conn *ec2.EC2, fleetID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.FleetStateCodeModifying, ec2.FleetStateCodeSubmitted},
+		Target:     []string{ec2.FleetStateCodeActive},
+		Refresh:    ec2FleetRefreshFunc(conn, fleetID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Fleet (%s) to be active", fleetID)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for EC2 Fleet (%s) to be active: %s", fleetID, err)
+	}
+
+	return nil
+}
+
+func waitForEc2FleetFulfillment(conn *ec2.EC2, fleetID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"", ec2.FleetActivityStatusPendingFulfillment},
+		Target:     []string{ec2.FleetActivityStatusFulfilled},
+		Refresh:    ec2FleetFulfillmentRefreshFunc(conn, fleetID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Fleet (%s) to be fulfilled", fleetID)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for EC2 Fleet (%s) to be fulfilled: %s", fleetID, err)
+	}
+
+	return nil
+}
+
+func ec2FleetFulfillmentRefreshFunc(conn *ec2.EC2, fleetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fleet, err := describeEc2Fleet(conn, fleetID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if fleet == nil {
+			return nil, "", fmt.Errorf("EC2 Fleet (%s) not found", fleetID)
+		}
+
+		status := aws.StringValue(fleet.ActivityStatus)
+		log.Printf("[DEBUG] EC2 Fleet (%s) fulfilled capacity: %.1f/%d", fleetID, aws.Float64Value(fleet.FulfilledCapacity), aws.Int64Value(fleet.TargetCapacitySpecification.TotalTargetCapacity))
+
+		if status == ec2.FleetActivityStatusError {
+			return fleet, status, fmt.Errorf("EC2 Fleet (%s) in error status: %s", fleetID, ec2FleetLastErrors(conn, fleet))
+		}
+
+		return fleet, status, nil
+	}
+}
+
+// ec2FleetLastErrors returns a description of the service errors recorded in the
+// fleet history, to explain why a fleet could not be fulfilled.
+func ec2FleetLastErrors(conn *ec2.EC2, fleet *ec2.FleetData) string {
+	output, err := conn.DescribeFleetHistory(&ec2.DescribeFleetHistoryInput{
+		EventType: aws.String(ec2.FleetEventTypeServiceError),
+		FleetId:   fleet.FleetId,
+		StartTime: fleet.CreateTime,
+	})
+	if err != nil {
+		log.Printf("[ERROR] Failed to get the reason of EC2 Fleet (%s) error status: %s", aws.StringValue(fleet.FleetId), err)
+		return "unknown"
+	}
+
+	var events []string
+	for _, record := range output.HistoryRecords {
+		if record.EventInformation == nil {
+			continue
+		}
+		events = append(events, fmt.Sprintf("%s: %s", aws.StringValue(record.EventInformation.EventSubType), aws.StringValue(record.EventInformation.EventDescription)))
+	}
+
+	if len(events) == 0 {
+		return "unknown"
+	}
+
+	return fmt.Sprintf("last events: %v", events)
+}
+
+func expandEc2FleetLaunchTemplateConfigRequests(l []interface{}) ([]*ec2.FleetLaunchTemplateConfigRequest, error) {
+	var configs []*ec2.FleetLaunchTemplateConfigRequest
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		config := &ec2.FleetLaunchTemplateConfigRequest{}
+
+		if v, ok := m["launch_template_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			spec := v[0].(map[string]interface{})
+			id := spec["launch_template_id"].(string)
+			name := spec["launch_template_name"].(string)
+
+			if (id == "") == (name == "") {
+				return nil, fmt.Errorf("exactly one of launch_template_id or launch_template_name must be specified in launch_template_specification")
+			}
+
+			config.LaunchTemplateSpecification = &ec2.FleetLaunchTemplateSpecificationRequest{
+				Version: aws.String(spec["version"].(string)),
+			}
+			if id != "" {
+				config.LaunchTemplateSpecification.LaunchTemplateId = aws.String(id)
+			}
+			if name != "" {
+				config.LaunchTemplateSpecification.LaunchTemplateName = aws.String(name)
+			}
+		}
+
+		if v, ok := m["override"].([]interface{}); ok {
+			config.Overrides = expandEc2FleetLaunchTemplateOverridesRequests(v)
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs, nil
+}
+
+func expandEc2FleetLaunchTemplateOverridesRequests(l []interface{}) []*ec2.FleetLaunchTemplateOverridesRequest {
+	var overrides []*ec2.FleetLaunchTemplateOverridesRequest
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		override := &ec2.FleetLaunchTemplateOverridesRequest{}
+
+		if v, ok := m["availability_zone"].(string); ok && v != "" {
+			override.AvailabilityZone = aws.String(v)
+		}
+		if v, ok := m["instance_type"].(string); ok && v != "" {
+			override.InstanceType = aws.String(v)
+		}
+		if v, ok := m["max_price"].(string); ok && v != "" {
+			override.MaxPrice = aws.String(v)
+		}
+		if v, ok := m["priority"].(float64); ok && v != 0 {
+			override.Priority = aws.Float64(v)
+		}
+		if v, ok := m["subnet_id"].(string); ok && v != "" {
+			override.SubnetId = aws.String(v)
+		}
+		if v, ok := m["weighted_capacity"].(float64); ok && v != 0 {
+			override.WeightedCapacity = aws.Float64(v)
+		}
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides
+}
+
+func expandEc2OnDemandOptionsRequest(l []interface{}) *ec2.OnDemandOptionsRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &ec2.OnDemandOptionsRequest{
+		AllocationStrategy: aws.String(m["allocation_strategy"].(string)),
+	}
+}
+
+func expandEc2SpotOptionsRequest(l []interface{}) *ec2.SpotOptionsRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	options := &ec2.SpotOptionsRequest{
+		AllocationStrategy:           aws.String(m["allocation_strategy"].(string)),
+		InstanceInterruptionBehavior: aws.String(m["instance_interruption_behavior"].(string)),
+	}
+
+	// InstancePoolsToUseCount is only valid with the lowest-price strategy
+	if m["allocation_strategy"].(string) == ec2.SpotAllocationStrategyLowestPrice {
+		options.InstancePoolsToUseCount = aws.Int64(int64(m["instance_pools_to_use_count"].(int)))
+	}
+
+	return options
+}
+
+func expandEc2TargetCapacitySpecificationRequest(l []interface{}) *ec2.TargetCapacitySpecificationRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	spec := &ec2.TargetCapacitySpecificationRequest{
+		DefaultTargetCapacityType: aws.String(m["default_target_capacity_type"].(string)),
+		TotalTargetCapacity:       aws.Int64(int64(m["total_target_capacity"].(int))),
+	}
+
+	if v, ok := m["on_demand_target_capacity"].(int); ok && v != 0 {
+		spec.OnDemandTargetCapacity = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["spot_target_capacity"].(int); ok && v != 0 {
+		spec.SpotTargetCapacity = aws.Int64(int64(v))
+	}
+
+	return spec
+}
+
+func flattenEc2FleetLaunchTemplateConfigs(configs []*ec2.FleetLaunchTemplateConfig) []interface{} {
+	l := make([]interface{}, 0, len(configs))
+
+	for _, config := range configs {
+		if config == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"launch_template_specification": flattenEc2FleetLaunchTemplateSpecification(config.LaunchTemplateSpecification),
+			"override":                      flattenEc2FleetLaunchTemplateOverrides(config.Overrides),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenEc2FleetLaunchTemplateSpecification(spec *ec2.FleetLaunchTemplateSpecification) []interface{} {
+	if spec == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"launch_template_id":   aws.StringValue(spec.LaunchTemplateId),
+		"launch_template_name": aws.StringValue(spec.LaunchTemplateName),
+		"version":              aws.StringValue(spec.Version),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenEc2FleetLaunchTemplateOverrides(overrides []*ec2.FleetLaunchTemplateOverrides) []interface{} {
+	l := make([]interface{}, 0, len(overrides))
+
+	for _, override := range overrides {
+		if override == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"availability_zone": aws.StringValue(override.AvailabilityZone),
+			"instance_type":     aws.StringValue(override.InstanceType),
+			"max_price":         aws.StringValue(override.MaxPrice),
+			"priority":          aws.Float64Value(override.Priority),
+			"subnet_id":         aws.StringValue(override.SubnetId),
+			"weighted_capacity": aws.Float64Value(override.WeightedCapacity),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenEc2OnDemandOptions(options *ec2.OnDemandOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"allocation_strategy": aws.StringValue(options.AllocationStrategy),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenEc2SpotOptions(options *ec2.SpotOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"allocation_strategy":            aws.StringValue(options.AllocationStrategy),
+		"instance_interruption_behavior": aws.StringValue(options.InstanceInterruptionBehavior),
+		"instance_pools_to_use_count":    aws.Int64Value(options.InstancePoolsToUseCount),
+	}
+
+	// API will omit InstancePoolsToUseCount if not lowest-price
+	if options.InstancePoolsToUseCount == nil {
+		m["instance_pools_to_use_count"] = 1
+	}
+
+	return []interface{}{m}
+}
+
+func flattenEc2TargetCapacitySpecification(spec *ec2.TargetCapacitySpecification) []interface{} {
+	if spec == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"default_target_capacity_type": aws.StringValue(spec.DefaultTargetCapacityType),
+		"on_demand_target_capacity":    aws.Int64Value(spec.OnDemandTargetCapacity),
+		"spot_target_capacity":         aws.Int64Value(spec.SpotTargetCapacity),
+		"total_target_capacity":        aws.Int64Value(spec.TotalTargetCapacity),
+	}
+
+	return []interface{}{m}
+}
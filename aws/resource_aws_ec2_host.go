@@ -0,0 +1,204 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2Host() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2HostCreate,
+		Read:   resourceAwsEc2HostRead,
+		Update: resourceAwsEc2HostUpdate,
+		Delete: resourceAwsEc2HostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_placement": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.AutoPlacementOn,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.AutoPlacementOff,
+					ec2.AutoPlacementOn,
+				}, false),
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEc2HostCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.AllocateHostsInput{
+		AutoPlacement:    aws.String(d.Get("auto_placement").(string)),
+		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
+		InstanceType:     aws.String(d.Get("instance_type").(string)),
+		Quantity:         aws.Int64(1),
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.TagSpecifications = []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeDedicatedHost),
+				Tags:         tagsFromMap(v.(map[string]interface{})),
+			},
+		}
+	}
+
+	log.Printf("[DEBUG] Allocating EC2 Host: %s", input)
+	output, err := conn.AllocateHosts(input)
+	if err != nil {
+		return fmt.Errorf("error allocating EC2 Host: %s", err)
+	}
+
+	if len(output.HostIds) == 0 {
+		return fmt.Errorf("error allocating EC2 Host: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.HostIds[0]))
+
+	return resourceAwsEc2HostRead(d, meta)
+}
+
+func resourceAwsEc2HostRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	host, err := describeEc2Host(conn, d.Id())
+	if isAWSErr(err, "InvalidHostID.NotFound", "") {
+		log.Printf("[WARN] EC2 Host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Host (%s): %s", d.Id(), err)
+	}
+
+	if host == nil {
+		log.Printf("[WARN] EC2 Host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(host.State) {
+	case ec2.AllocationStateReleased, ec2.AllocationStateReleasedPermanentFailure:
+		log.Printf("[WARN] EC2 Host (%s) in released state (%s), removing from state", d.Id(), aws.StringValue(host.State))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("auto_placement", host.AutoPlacement)
+	d.Set("availability_zone", host.AvailabilityZone)
+
+	d.Set("instance_type", "")
+	if host.HostProperties != nil {
+		d.Set("instance_type", host.HostProperties.InstanceType)
+	}
+
+	if err := d.Set("tags", tagsToMap(host.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2HostUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	d.Partial(true)
+
+	if d.HasChange("auto_placement") {
+		input := &ec2.ModifyHostsInput{
+			AutoPlacement: aws.String(d.Get("auto_placement").(string)),
+			HostIds:       []*string{aws.String(d.Id())},
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Host: %s", input)
+		output, err := conn.ModifyHosts(input)
+		if err != nil {
+			return fmt.Errorf("error modifying EC2 Host (%s): %s", d.Id(), err)
+		}
+
+		if err := ec2HostUnsuccessfulItemsError(output.Unsuccessful); err != nil {
+			return fmt.Errorf("error modifying EC2 Host (%s): %s", d.Id(), err)
+		}
+
+		d.SetPartial("auto_placement")
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Host (%s) tags: %s", d.Id(), err)
+	}
+	d.SetPartial("tags")
+
+	d.Partial(false)
+
+	return resourceAwsEc2HostRead(d, meta)
+}
+
+func resourceAwsEc2HostDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Releasing EC2 Host: %s", d.Id())
+	output, err := conn.ReleaseHosts(&ec2.ReleaseHostsInput{
+		HostIds: []*string{aws.String(d.Id())},
+	})
+	if isAWSErr(err, "InvalidHostID.NotFound", "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error releasing EC2 Host (%s): %s", d.Id(), err)
+	}
+
+	if err := ec2HostUnsuccessfulItemsError(output.Unsuccessful); err != nil {
+		return fmt.Errorf("error releasing EC2 Host (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeEc2Host(conn *ec2.EC2, hostID string) (*ec2.Host, error) {
+	output, err := conn.DescribeHosts(&ec2.DescribeHostsInput{
+		HostIds: []*string{aws.String(hostID)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, host := range output.Hosts {
+		if aws.StringValue(host.HostId) == hostID {
+			return host, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2HostUnsuccessfulItemsError(items []*ec2.UnsuccessfulItem) error {
+	for _, item := range items {
+		if item == nil || item.Error == nil {
+			continue
+		}
+		return fmt.Errorf("%s: %s", aws.StringValue(item.Error.Code), aws.StringValue(item.Error.Message))
+	}
+
+	return nil
+}
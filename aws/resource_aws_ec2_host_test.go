@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2Host_basic(t *testing.T) {
+	var host ec2.Host
+	resourceName := "aws_ec2_host.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostConfig("on"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "auto_placement", "on"),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "m5.large"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2HostConfig("off"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "auto_placement", "off"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2Host_Tags(t *testing.T) {
+	var host ec2.Host
+	resourceName := "aws_ec2_host.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostConfigTags("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSEc2HostConfigTags("key1", "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2HostExists(n string, v *ec2.Host) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Host ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		host, err := describeEc2Host(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if host == nil {
+			return fmt.Errorf("EC2 Host (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *host
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2HostDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_host" {
+			continue
+		}
+
+		host, err := describeEc2Host(conn, rs.Primary.ID)
+		if isAWSErr(err, "InvalidHostID.NotFound", "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if host == nil {
+			continue
+		}
+
+		if state := aws.StringValue(host.State); state != ec2.AllocationStateReleased && state != ec2.AllocationStateReleasedPermanentFailure {
+			return fmt.Errorf("EC2 Host (%s) still exists in non-released (%s) state", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEc2HostConfig(autoPlacement string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_ec2_host" "test" {
+  auto_placement    = %q
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_type     = "m5.large"
+}
+`, autoPlacement)
+}
+
+func testAccAWSEc2HostConfigTags(key1, value1 string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_ec2_host" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_type     = "m5.large"
+
+  tags {
+    %q = %q
+  }
+}
+`, key1, value1)
+}
@@ -0,0 +1,280 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+)
+
+// Vault lock states, which the Glacier API does not define as enums.
+const (
+	glacierVaultLockStateInProgress = "InProgress"
+	glacierVaultLockStateLocked     = "Locked"
+)
+
+func resourceAwsGlacierVaultLock() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlacierVaultLockCreate,
+		Read:   resourceAwsGlacierVaultLockRead,
+		Update: resourceAwsGlacierVaultLockUpdate,
+		Delete: resourceAwsGlacierVaultLockDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsGlacierVaultLockCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateIAMPolicyJson,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"complete_lock": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ignore_deletion_error": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"lock_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGlacierVaultLockCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	// A completed lock is immutable: its policy can't be changed and the
+	// lock can neither be reverted to in progress nor removed.
+	if o, _ := diff.GetChange("complete_lock"); !o.(bool) {
+		return nil
+	}
+
+	if !diff.Get("complete_lock").(bool) {
+		return fmt.Errorf("Glacier Vault Lock (%s) is complete and cannot be reverted to in progress", diff.Id())
+	}
+
+	if diff.HasChange("policy") {
+		return fmt.Errorf("Glacier Vault Lock (%s) is complete and its policy cannot be changed", diff.Id())
+	}
+
+	return nil
+}
+
+func resourceAwsGlacierVaultLockCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glacierconn
+	vaultName := d.Get("vault_name").(string)
+
+	if err := glacierVaultLockInitiate(conn, d); err != nil {
+		return err
+	}
+
+	d.SetId(vaultName)
+
+	if d.Get("complete_lock").(bool) {
+		if err := glacierVaultLockComplete(conn, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsGlacierVaultLockRead(d, meta)
+}
+
+func resourceAwsGlacierVaultLockRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glacierconn
+
+	output, err := conn.GetVaultLock(&glacier.GetVaultLockInput{
+		VaultName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, glacier.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Glacier Vault Lock (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Glacier Vault Lock (%s): %s", d.Id(), err)
+	}
+
+	policy, err := structure.NormalizeJsonString(aws.StringValue(output.Policy))
+	if err != nil {
+		return fmt.Errorf("Glacier Vault Lock (%s) policy contains an invalid JSON: %s", d.Id(), err)
+	}
+
+	state := aws.StringValue(output.State)
+
+	d.Set("vault_name", d.Id())
+	d.Set("policy", policy)
+	d.Set("complete_lock", state == glacierVaultLockStateLocked)
+	d.Set("state", state)
+	d.Set("expiration_date", output.ExpirationDate)
+
+	return nil
+}
+
+func resourceAwsGlacierVaultLockUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glacierconn
+
+	// An in-progress lock can't be changed, so a new policy is applied by
+	// aborting the lock and initiating it again, which also restarts the
+	// 24 hour window for completing it.
+	if d.HasChange("policy") {
+		if err := glacierVaultLockAbort(conn, d.Id()); err != nil {
+			return err
+		}
+
+		if err := glacierVaultLockInitiate(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("complete_lock") && d.Get("complete_lock").(bool) {
+		// The lock ID is only returned when the lock is initiated, so it
+		// isn't known for imported locks.
+		if d.Get("lock_id").(string) == "" {
+			log.Printf("[DEBUG] Glacier Vault Lock (%s) ID unknown, initiating the lock again", d.Id())
+			if err := glacierVaultLockAbort(conn, d.Id()); err != nil {
+				return err
+			}
+
+			if err := glacierVaultLockInitiate(conn, d); err != nil {
+				return err
+			}
+		}
+
+		if err := glacierVaultLockComplete(conn, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsGlacierVaultLockRead(d, meta)
+}
+
+func resourceAwsGlacierVaultLockDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glacierconn
+
+	output, err := conn.GetVaultLock(&glacier.GetVaultLockInput{
+		VaultName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, glacier.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Glacier Vault Lock (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(output.State) == glacierVaultLockStateLocked {
+		if d.Get("ignore_deletion_error").(bool) {
+			log.Printf("[WARN] Glacier Vault Lock (%s) is complete and cannot be removed, removing from state only", d.Id())
+			return nil
+		}
+		return fmt.Errorf("Glacier Vault Lock (%s) is complete and cannot be removed, set ignore_deletion_error to only remove it from the Terraform state", d.Id())
+	}
+
+	return glacierVaultLockAbort(conn, d.Id())
+}
+
+func glacierVaultLockInitiate(conn *glacier.Glacier, d *schema.ResourceData) error {
+	vaultName := d.Get("vault_name").(string)
+
+	input := &glacier.InitiateVaultLockInput{
+		VaultName: aws.String(vaultName),
+		Policy: &glacier.VaultLockPolicy{
+			Policy: aws.String(d.Get("policy").(string)),
+		},
+	}
+
+	log.Printf("[DEBUG] Initiating Glacier Vault Lock: %s", input)
+	output, err := conn.InitiateVaultLock(input)
+	if err != nil {
+		return fmt.Errorf("error initiating Glacier Vault Lock (%s): %s", vaultName, err)
+	}
+
+	d.Set("lock_id", output.LockId)
+
+	return nil
+}
+
+func glacierVaultLockComplete(conn *glacier.Glacier, d *schema.ResourceData) error {
+	vaultName := d.Get("vault_name").(string)
+
+	log.Printf("[DEBUG] Completing Glacier Vault Lock: %s", vaultName)
+	_, err := conn.CompleteVaultLock(&glacier.CompleteVaultLockInput{
+		LockId:    aws.String(d.Get("lock_id").(string)),
+		VaultName: aws.String(vaultName),
+	})
+	if err != nil {
+		return fmt.Errorf("error completing Glacier Vault Lock (%s): %s", vaultName, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{glacierVaultLockStateInProgress},
+		Target:  []string{glacierVaultLockStateLocked},
+		Refresh: glacierVaultLockRefreshFunc(conn, vaultName),
+		Timeout: 5 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Glacier Vault Lock (%s) completion: %s", vaultName, err)
+	}
+
+	return nil
+}
+
+func glacierVaultLockAbort(conn *glacier.Glacier, vaultName string) error {
+	log.Printf("[DEBUG] Aborting Glacier Vault Lock: %s", vaultName)
+	_, err := conn.AbortVaultLock(&glacier.AbortVaultLockInput{
+		VaultName: aws.String(vaultName),
+	})
+	if isAWSErr(err, glacier.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error aborting Glacier Vault Lock (%s): %s", vaultName, err)
+	}
+
+	return nil
+}
+
+func glacierVaultLockRefreshFunc(conn *glacier.Glacier, vaultName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetVaultLock(&glacier.GetVaultLockInput{
+			VaultName: aws.String(vaultName),
+		})
+		if isAWSErr(err, glacier.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
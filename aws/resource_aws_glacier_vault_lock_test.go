@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlacierVaultLock_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_glacier_vault_lock.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlacierVaultLockDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlacierVaultLockConfig(rInt, 365, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlacierVaultLockExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "InProgress"),
+					resource.TestCheckResourceAttrSet(resourceName, "lock_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "vault_name", "aws_glacier_vault.test", "name"),
+				),
+			},
+			{
+				Config: testAccGlacierVaultLockConfig(rInt, 730, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlacierVaultLockExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "InProgress"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_deletion_error", "lock_id"},
+			},
+		},
+	})
+}
+
+func TestAccAWSGlacierVaultLock_completeLock(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_glacier_vault_lock.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlacierVaultLockDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlacierVaultLockConfig(rInt, 365, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlacierVaultLockExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "InProgress"),
+				),
+			},
+			{
+				Config: testAccGlacierVaultLockConfig(rInt, 365, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlacierVaultLockExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "Locked"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlacierVaultLockExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glacier Vault Lock ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glacierconn
+		_, err := conn.GetVaultLock(&glacier.GetVaultLockInput{
+			VaultName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckGlacierVaultLockDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glacierconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glacier_vault_lock" {
+			continue
+		}
+
+		output, err := conn.GetVaultLock(&glacier.GetVaultLockInput{
+			VaultName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, glacier.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// Completed locks are only removed along with their vault.
+		if aws.StringValue(output.State) == glacierVaultLockStateInProgress {
+			return fmt.Errorf("Glacier Vault Lock (%s) still in progress", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccGlacierVaultLockConfig(rInt, days int, completeLock bool) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "deny-delete-for-%[2]d-days"
+    actions   = ["glacier:DeleteArchive"]
+    effect    = "Deny"
+    resources = ["${aws_glacier_vault.test.arn}"]
+
+    principals {
+      identifiers = ["*"]
+      type        = "*"
+    }
+
+    condition {
+      test     = "NumericLessThanEquals"
+      variable = "glacier:ArchiveAgeinDays"
+      values   = ["%[2]d"]
+    }
+  }
+}
+
+resource "aws_glacier_vault" "test" {
+  name = "tf-test-vault-lock-%[1]d"
+}
+
+resource "aws_glacier_vault_lock" "test" {
+  vault_name            = "${aws_glacier_vault.test.name}"
+  policy                = "${data.aws_iam_policy_document.test.json}"
+  complete_lock         = %[3]t
+  ignore_deletion_error = %[3]t
+}
+`, rInt, days, completeLock)
+}
@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIamServiceSpecificCredential() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamServiceSpecificCredentialCreate,
+		Read:   resourceAwsIamServiceSpecificCredentialRead,
+		Update: resourceAwsIamServiceSpecificCredentialUpdate,
+		Delete: resourceAwsIamServiceSpecificCredentialDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  iam.StatusTypeActive,
+				ValidateFunc: validation.StringInSlice([]string{
+					iam.StatusTypeActive,
+					iam.StatusTypeInactive,
+				}, false),
+			},
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"service_specific_credential_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_service_password": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamServiceSpecificCredentialCreate(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	input := &iam.CreateServiceSpecificCredentialInput{
+		ServiceName: aws.String(d.Get("service_name").(string)),
+		UserName:    aws.String(d.Get("user_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating IAM Service Specific Credential: %s", input)
+	output, err := iamconn.CreateServiceSpecificCredential(input)
+	if err != nil {
+		return fmt.Errorf("error creating IAM Service Specific Credential: %s", err)
+	}
+
+	cred := output.ServiceSpecificCredential
+	d.SetId(fmt.Sprintf("%s:%s:%s", aws.StringValue(cred.ServiceName), aws.StringValue(cred.UserName), aws.StringValue(cred.ServiceSpecificCredentialId)))
+
+	if v, ok := d.GetOk("pgp_key"); ok {
+		fingerprint, encrypted, err := encryptValueWithPgpKey(v.(string), aws.StringValue(cred.ServicePassword), "IAM Service Specific Credential Password")
+		if err != nil {
+			return err
+		}
+
+		d.Set("key_fingerprint", fingerprint)
+		d.Set("encrypted_service_password", encrypted)
+	} else {
+		d.Set("service_password", cred.ServicePassword)
+	}
+
+	if v := d.Get("status").(string); v != iam.StatusTypeActive {
+		if err := updateIamServiceSpecificCredentialStatus(iamconn, d, v); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsIamServiceSpecificCredentialRead(d, meta)
+}
+
+func resourceAwsIamServiceSpecificCredentialRead(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	serviceName, userName, credID, err := decodeIamServiceSpecificCredentialId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &iam.ListServiceSpecificCredentialsInput{
+		ServiceName: aws.String(serviceName),
+		UserName:    aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Reading IAM Service Specific Credentials: %s", input)
+	output, err := iamconn.ListServiceSpecificCredentials(input)
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		log.Printf("[WARN] IAM User (%s) not found, removing IAM Service Specific Credential %s from state", userName, d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading IAM Service Specific Credential (%s): %s", d.Id(), err)
+	}
+
+	var cred *iam.ServiceSpecificCredentialMetadata
+	for _, c := range output.ServiceSpecificCredentials {
+		if aws.StringValue(c.ServiceSpecificCredentialId) == credID {
+			cred = c
+			break
+		}
+	}
+
+	if cred == nil {
+		log.Printf("[WARN] IAM Service Specific Credential (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("service_name", cred.ServiceName)
+	d.Set("service_specific_credential_id", cred.ServiceSpecificCredentialId)
+	d.Set("service_user_name", cred.ServiceUserName)
+	d.Set("status", cred.Status)
+	d.Set("user_name", cred.UserName)
+
+	return nil
+}
+
+func resourceAwsIamServiceSpecificCredentialUpdate(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	if d.HasChange("status") {
+		if err := updateIamServiceSpecificCredentialStatus(iamconn, d, d.Get("status").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsIamServiceSpecificCredentialRead(d, meta)
+}
+
+func resourceAwsIamServiceSpecificCredentialDelete(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	_, userName, credID, err := decodeIamServiceSpecificCredentialId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &iam.DeleteServiceSpecificCredentialInput{
+		ServiceSpecificCredentialId: aws.String(credID),
+		UserName:                    aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Deleting IAM Service Specific Credential: %s", input)
+	_, err = iamconn.DeleteServiceSpecificCredential(input)
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Service Specific Credential (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateIamServiceSpecificCredentialStatus(iamconn *iam.IAM, d *schema.ResourceData, status string) error {
+	_, userName, credID, err := decodeIamServiceSpecificCredentialId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &iam.UpdateServiceSpecificCredentialInput{
+		ServiceSpecificCredentialId: aws.String(credID),
+		Status:                      aws.String(status),
+		UserName:                    aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Updating IAM Service Specific Credential status: %s", input)
+	if _, err := iamconn.UpdateServiceSpecificCredential(input); err != nil {
+		return fmt.Errorf("error updating IAM Service Specific Credential (%s) status: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeIamServiceSpecificCredentialId(id string) (string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected SERVICE-NAME:USER-NAME:SERVICE-SPECIFIC-CREDENTIAL-ID", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
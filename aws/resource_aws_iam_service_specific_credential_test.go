@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/helper/pgpkeys"
+)
+
+func TestAccAWSIAMServiceSpecificCredential_basic(t *testing.T) {
+	var cred iam.ServiceSpecificCredentialMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_service_specific_credential.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMServiceSpecificCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMServiceSpecificCredentialConfig(rName, "Active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMServiceSpecificCredentialExists(resourceName, &cred),
+					resource.TestCheckResourceAttr(resourceName, "service_name", "codecommit.amazonaws.com"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_name", "aws_iam_user.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_password"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_specific_credential_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_user_name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"service_password"},
+			},
+			{
+				Config: testAccAWSIAMServiceSpecificCredentialConfig(rName, "Inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMServiceSpecificCredentialExists(resourceName, &cred),
+					resource.TestCheckResourceAttr(resourceName, "status", "Inactive"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMServiceSpecificCredential_pgpKey(t *testing.T) {
+	var cred iam.ServiceSpecificCredentialMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_service_specific_credential.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMServiceSpecificCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMServiceSpecificCredentialConfigPgpKey(rName, testPubKey1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMServiceSpecificCredentialExists(resourceName, &cred),
+					testDecryptAttributeAndTest(resourceName, "encrypted_service_password", testPrivKey1),
+					resource.TestCheckResourceAttr(resourceName, "service_password", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "key_fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIAMServiceSpecificCredentialExists(n string, res *iam.ServiceSpecificCredentialMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IAM Service Specific Credential ID is set")
+		}
+
+		cred, err := testAccFindIAMServiceSpecificCredential(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if cred == nil {
+			return fmt.Errorf("IAM Service Specific Credential (%s) not found", rs.Primary.ID)
+		}
+
+		*res = *cred
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIAMServiceSpecificCredentialDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_service_specific_credential" {
+			continue
+		}
+
+		cred, err := testAccFindIAMServiceSpecificCredential(rs.Primary.ID)
+		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if cred != nil {
+			return fmt.Errorf("IAM Service Specific Credential (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccFindIAMServiceSpecificCredential(id string) (*iam.ServiceSpecificCredentialMetadata, error) {
+	conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+	serviceName, userName, credID, err := decodeIamServiceSpecificCredentialId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := conn.ListServiceSpecificCredentials(&iam.ListServiceSpecificCredentialsInput{
+		ServiceName: aws.String(serviceName),
+		UserName:    aws.String(userName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cred := range output.ServiceSpecificCredentials {
+		if aws.StringValue(cred.ServiceSpecificCredentialId) == credID {
+			return cred, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func testDecryptAttributeAndTest(n, attr, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		encrypted, ok := rs.Primary.Attributes[attr]
+		if !ok || encrypted == "" {
+			return fmt.Errorf("No %s in state", attr)
+		}
+
+		// We can't verify that the decrypted value is correct, because we don't
+		// have it. We can verify that decrypting it does not error
+		if _, err := pgpkeys.DecryptBytes(encrypted, key); err != nil {
+			return fmt.Errorf("Error decrypting %s: %s", attr, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSIAMServiceSpecificCredentialConfig(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_service_specific_credential" "test" {
+  service_name = "codecommit.amazonaws.com"
+  user_name    = "${aws_iam_user.test.name}"
+  status       = %[2]q
+}
+`, rName, status)
+}
+
+func testAccAWSIAMServiceSpecificCredentialConfigPgpKey(rName, key string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_service_specific_credential" "test" {
+  service_name = "codecommit.amazonaws.com"
+  user_name    = "${aws_iam_user.test.name}"
+
+  pgp_key = <<EOF
+%[2]s
+EOF
+}
+`, rName, key)
+}
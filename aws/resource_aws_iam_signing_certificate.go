@@ -0,0 +1,190 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIamSigningCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamSigningCertificateCreate,
+		Read:   resourceAwsIamSigningCertificateRead,
+		Update: resourceAwsIamSigningCertificateUpdate,
+		Delete: resourceAwsIamSigningCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_body": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: normalizeCert,
+			},
+			"certificate_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  iam.StatusTypeActive,
+				ValidateFunc: validation.StringInSlice([]string{
+					iam.StatusTypeActive,
+					iam.StatusTypeInactive,
+				}, false),
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamSigningCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	input := &iam.UploadSigningCertificateInput{
+		CertificateBody: aws.String(d.Get("certificate_body").(string)),
+		UserName:        aws.String(d.Get("user_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Uploading IAM Signing Certificate for user %s", d.Get("user_name").(string))
+	output, err := iamconn.UploadSigningCertificate(input)
+	if err != nil {
+		return fmt.Errorf("error uploading IAM Signing Certificate: %s", err)
+	}
+
+	cert := output.Certificate
+	d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(cert.CertificateId), aws.StringValue(cert.UserName)))
+
+	if v := d.Get("status").(string); v != iam.StatusTypeActive {
+		if err := updateIamSigningCertificateStatus(iamconn, d, v); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsIamSigningCertificateRead(d, meta)
+}
+
+func resourceAwsIamSigningCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	certID, userName, err := decodeIamSigningCertificateId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &iam.ListSigningCertificatesInput{
+		UserName: aws.String(userName),
+	}
+
+	var cert *iam.SigningCertificate
+	log.Printf("[DEBUG] Reading IAM Signing Certificates: %s", input)
+	err = iamconn.ListSigningCertificatesPages(input, func(page *iam.ListSigningCertificatesOutput, lastPage bool) bool {
+		for _, c := range page.Certificates {
+			if aws.StringValue(c.CertificateId) == certID {
+				cert = c
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		log.Printf("[WARN] IAM User (%s) not found, removing IAM Signing Certificate %s from state", userName, d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading IAM Signing Certificate (%s): %s", d.Id(), err)
+	}
+
+	if cert == nil {
+		log.Printf("[WARN] IAM Signing Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("certificate_body", normalizeCert(cert.CertificateBody))
+	d.Set("certificate_id", cert.CertificateId)
+	d.Set("status", cert.Status)
+	d.Set("user_name", cert.UserName)
+
+	return nil
+}
+
+func resourceAwsIamSigningCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	if d.HasChange("status") {
+		if err := updateIamSigningCertificateStatus(iamconn, d, d.Get("status").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsIamSigningCertificateRead(d, meta)
+}
+
+func resourceAwsIamSigningCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	certID, userName, err := decodeIamSigningCertificateId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &iam.DeleteSigningCertificateInput{
+		CertificateId: aws.String(certID),
+		UserName:      aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Deleting IAM Signing Certificate: %s", input)
+	_, err = iamconn.DeleteSigningCertificate(input)
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Signing Certificate (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateIamSigningCertificateStatus(iamconn *iam.IAM, d *schema.ResourceData, status string) error {
+	certID, userName, err := decodeIamSigningCertificateId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &iam.UpdateSigningCertificateInput{
+		CertificateId: aws.String(certID),
+		Status:        aws.String(status),
+		UserName:      aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Updating IAM Signing Certificate status: %s", input)
+	if _, err := iamconn.UpdateSigningCertificate(input); err != nil {
+		return fmt.Errorf("error updating IAM Signing Certificate (%s) status: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeIamSigningCertificateId(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected CERTIFICATE-ID:USER-NAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIAMSigningCertificate_basic(t *testing.T) {
+	var cred iam.SigningCertificate
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_signing_certificate.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMSigningCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMSigningCertificateConfig(rName, "Active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMSigningCertificateExists(resourceName, &cred),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_name", "aws_iam_user.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIAMSigningCertificateConfig(rName, "Inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMSigningCertificateExists(resourceName, &cred),
+					resource.TestCheckResourceAttr(resourceName, "status", "Inactive"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIAMSigningCertificateExists(n string, res *iam.SigningCertificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IAM Signing Certificate ID is set")
+		}
+
+		cert, err := testAccFindIAMSigningCertificate(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if cert == nil {
+			return fmt.Errorf("IAM Signing Certificate (%s) not found", rs.Primary.ID)
+		}
+
+		*res = *cert
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIAMSigningCertificateDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_signing_certificate" {
+			continue
+		}
+
+		cert, err := testAccFindIAMSigningCertificate(rs.Primary.ID)
+		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if cert != nil {
+			return fmt.Errorf("IAM Signing Certificate (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccFindIAMSigningCertificate(id string) (*iam.SigningCertificate, error) {
+	conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+	certId, userName, err := decodeIamSigningCertificateId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := conn.ListSigningCertificates(&iam.ListSigningCertificatesInput{
+		UserName: aws.String(userName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cert := range output.Certificates {
+		if aws.StringValue(cert.CertificateId) == certId {
+			return cert, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func testAccAWSIAMSigningCertificateConfig(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_signing_certificate" "test" {
+  user_name = "${aws_iam_user.test.name}"
+  status    = %[2]q
+
+  certificate_body = <<EOF
+-----BEGIN CERTIFICATE-----
+MIID2jCCAsKgAwIBAgIJAJ58TJVjU7G1MA0GCSqGSIb3DQEBBQUAMFExCzAJBgNV
+BAYTAlVTMREwDwYDVQQIEwhDb2xvcmFkbzEPMA0GA1UEBxMGRGVudmVyMRAwDgYD
+VQQKEwdDaGFydGVyMQwwCgYDVQQLEwNDU0UwHhcNMTcwMTMwMTkyMDA4WhcNMjYx
+MjA5MTkyMDA4WjBRMQswCQYDVQQGEwJVUzERMA8GA1UECBMIQ29sb3JhZG8xDzAN
+BgNVBAcTBkRlbnZlcjEQMA4GA1UEChMHQ2hhcnRlcjEMMAoGA1UECxMDQ1NFMIIB
+IjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAv6dq6VLIImlAaTrckb5w3X6J
+WP7EGz2ChGAXlkEYto6dPCba0v5+f+8UlMOpeB25XGoai7gdItqNWVFpYsgmndx3
+vTad3ukO1zeElKtw5oHPH2plOaiv/gVJaDa9NTeINj0EtGZs74fCOclAzGFX5vBc
+b08ESWBceRgGjGv3nlij4JzHfqTkCKQz6P6pBivQBfk62rcOkkH5rKoaGltRHROS
+MbkwOhu2hN0KmSYTXRvts0LXnZU4N0l2ms39gmr7UNNNlKYINL2JoTs9dNBc7APD
+dZvlEHd+/FjcLCI8hC3t4g4AbfW0okIBCNG0+oVjqGb2DeONSJKsThahXt89MQID
+AQABo4G0MIGxMB0GA1UdDgQWBBQKq8JxjY1GmeZXJjfOMfW0kBIzPDCBgQYDVR0j
+BHoweIAUCqvCcY2NRpnmVyY3zjH1tJASMzyhVaRTMFExCzAJBgNVBAYTAlVTMREw
+DwYDVQQIEwhDb2xvcmFkbzEPMA0GA1UEBxMGRGVudmVyMRAwDgYDVQQKEwdDaGFy
+dGVyMQwwCgYDVQQLEwNDU0WCCQCefEyVY1OxtTAMBgNVHRMEBTADAQH/MA0GCSqG
+SIb3DQEBBQUAA4IBAQAWifoMk5kbv+yuWXvFwHiB4dWUUmMlUlPU/E300yVTRl58
+p6DfOgJs7MMftd1KeWqTO+uW134QlTt7+jwI8Jq0uyKCu/O2kJhVtH/Ryog14tGl
++wLcuIPLbwJI9CwZX4WMBrq4DnYss+6F47i8NCc+Z3MAiG4vtq9ytBmaod0dj2bI
+g4/Lac0e00dql9RnqENh1+dF0V+QgTJCoPkMqDNAlSB8vOodBW81UAb2z12t+IFi
+3X9J3WtCK2+T5brXL6itzewWJ2ALvX3QpmZx7fMHJ3tE+SjjyivE1BbOlzYHx83t
+TeYnm7pS9un7A/UzTDHbs7hPUezLek+H3xTPAnnq
+-----END CERTIFICATE-----
+EOF
+}
+`, rName, status)
+}
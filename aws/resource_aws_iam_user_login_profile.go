@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
-	"github.com/hashicorp/terraform/helper/encryption"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
@@ -116,16 +115,11 @@ func checkIAMPwdPolicy(pass []byte) bool {
 func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
 
-	encryptionKey, err := encryption.RetrieveGPGKey(d.Get("pgp_key").(string))
-	if err != nil {
-		return err
-	}
-
 	username := d.Get("user").(string)
 	passwordResetRequired := d.Get("password_reset_required").(bool)
 	passwordLength := d.Get("password_length").(int)
 
-	_, err = iamconn.GetLoginProfile(&iam.GetLoginProfileInput{
+	_, err := iamconn.GetLoginProfile(&iam.GetLoginProfileInput{
 		UserName: aws.String(username),
 	})
 	if err != nil {
@@ -142,7 +136,7 @@ func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface
 
 	initialPassword := generateIAMPassword(passwordLength)
 
-	fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, initialPassword, "Password")
+	fingerprint, encrypted, err := encryptValueWithPgpKey(d.Get("pgp_key").(string), initialPassword, "Password")
 	if err != nil {
 		return err
 	}
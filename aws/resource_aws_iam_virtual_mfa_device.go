@@ -0,0 +1,272 @@
+package aws
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIamVirtualMfaDevice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamVirtualMfaDeviceCreate,
+		Read:   resourceAwsIamVirtualMfaDeviceRead,
+		Delete: resourceAwsIamVirtualMfaDeviceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_mfa_device_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+				ForceNew: true,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enable_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_32_string_seed": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"qr_code_png": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_base_32_string_seed": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_qr_code_png": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamVirtualMfaDeviceCreate(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	input := &iam.CreateVirtualMFADeviceInput{
+		Path:                 aws.String(d.Get("path").(string)),
+		VirtualMFADeviceName: aws.String(d.Get("virtual_mfa_device_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating IAM Virtual MFA Device: %s", input)
+	output, err := iamconn.CreateVirtualMFADevice(input)
+	if err != nil {
+		return fmt.Errorf("error creating IAM Virtual MFA Device: %s", err)
+	}
+
+	device := output.VirtualMFADevice
+	d.SetId(aws.StringValue(device.SerialNumber))
+
+	seed := string(device.Base32StringSeed)
+	qrCodePng := base64.StdEncoding.EncodeToString(device.QRCodePNG)
+
+	if err := setPgpEncryptedAttribute(d, "base_32_string_seed", seed, "IAM Virtual MFA Device Seed"); err != nil {
+		return err
+	}
+	if err := setPgpEncryptedAttribute(d, "qr_code_png", qrCodePng, "IAM Virtual MFA Device QR Code"); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("user_name"); ok {
+		if err := enableIamVirtualMfaDevice(iamconn, d.Id(), v.(string), seed); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsIamVirtualMfaDeviceRead(d, meta)
+}
+
+func resourceAwsIamVirtualMfaDeviceRead(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	input := &iam.ListVirtualMFADevicesInput{
+		AssignmentStatus: aws.String(iam.AssignmentStatusTypeAny),
+	}
+
+	var device *iam.VirtualMFADevice
+	log.Printf("[DEBUG] Reading IAM Virtual MFA Devices: %s", input)
+	err := iamconn.ListVirtualMFADevicesPages(input, func(page *iam.ListVirtualMFADevicesOutput, lastPage bool) bool {
+		for _, v := range page.VirtualMFADevices {
+			if aws.StringValue(v.SerialNumber) == d.Id() {
+				device = v
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error reading IAM Virtual MFA Device (%s): %s", d.Id(), err)
+	}
+
+	if device == nil {
+		log.Printf("[WARN] IAM Virtual MFA Device (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	path, name, err := parseIamVirtualMfaDeviceArn(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", device.SerialNumber)
+	d.Set("path", path)
+	d.Set("virtual_mfa_device_name", name)
+
+	d.Set("user_name", "")
+	if device.User != nil {
+		d.Set("user_name", device.User.UserName)
+	}
+
+	d.Set("enable_date", "")
+	if device.EnableDate != nil {
+		d.Set("enable_date", aws.TimeValue(device.EnableDate).Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsIamVirtualMfaDeviceDelete(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	if v, ok := d.GetOk("user_name"); ok {
+		input := &iam.DeactivateMFADeviceInput{
+			SerialNumber: aws.String(d.Id()),
+			UserName:     aws.String(v.(string)),
+		}
+
+		log.Printf("[DEBUG] Deactivating IAM Virtual MFA Device: %s", input)
+		_, err := iamconn.DeactivateMFADevice(input)
+		if err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+			return fmt.Errorf("error deactivating IAM Virtual MFA Device (%s): %s", d.Id(), err)
+		}
+	}
+
+	input := &iam.DeleteVirtualMFADeviceInput{
+		SerialNumber: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting IAM Virtual MFA Device: %s", input)
+	_, err := iamconn.DeleteVirtualMFADevice(input)
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Virtual MFA Device (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// enableIamVirtualMfaDevice associates the device with the user, computing the
+// two consecutive authentication codes AWS requires from the device seed.
+func enableIamVirtualMfaDevice(iamconn *iam.IAM, serialNumber, userName, seed string) error {
+	key, err := base32.StdEncoding.DecodeString(seed)
+	if err != nil {
+		return fmt.Errorf("error decoding IAM Virtual MFA Device (%s) seed: %s", serialNumber, err)
+	}
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		counter := time.Now().Unix() / 30
+		input := &iam.EnableMFADeviceInput{
+			AuthenticationCode1: aws.String(totpCode(key, counter-1)),
+			AuthenticationCode2: aws.String(totpCode(key, counter)),
+			SerialNumber:        aws.String(serialNumber),
+			UserName:            aws.String(userName),
+		}
+
+		log.Printf("[DEBUG] Enabling IAM Virtual MFA Device (%s) for user %s", serialNumber, userName)
+		_, err := iamconn.EnableMFADevice(input)
+		// IAM is eventually consistent and codes may straddle a time step
+		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") ||
+			isAWSErr(err, iam.ErrCodeInvalidAuthenticationCodeException, "") ||
+			isAWSErr(err, iam.ErrCodeEntityTemporarilyUnmodifiableException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error enabling IAM Virtual MFA Device (%s): %s", serialNumber, err))
+		}
+		return nil
+	})
+}
+
+// totpCode returns the six digit RFC 6238 time-based one-time password for
+// the given key and 30 second time step counter.
+func totpCode(key []byte, counter int64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(counter))
+
+	h := hmac.New(sha1.New, key)
+	h.Write(msg)
+	sum := h.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%06d", code%1000000)
+}
+
+// parseIamVirtualMfaDeviceArn returns the path and name of a virtual MFA
+// device from its ARN, e.g. arn:aws:iam::123456789012:mfa/path/name.
+func parseIamVirtualMfaDeviceArn(s string) (string, string, error) {
+	parsedArn, err := arn.Parse(s)
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing IAM Virtual MFA Device ARN (%s): %s", s, err)
+	}
+
+	parts := strings.Split(parsedArn.Resource, "/")
+	if len(parts) < 2 || parts[0] != "mfa" {
+		return "", "", fmt.Errorf("Unexpected format of IAM Virtual MFA Device ARN (%q), expected arn:PARTITION:iam::ACCOUNT:mfa/PATH/NAME", s)
+	}
+
+	path := "/"
+	if len(parts) > 2 {
+		path = "/" + strings.Join(parts[1:len(parts)-1], "/") + "/"
+	}
+
+	return path, parts[len(parts)-1], nil
+}
@@ -0,0 +1,232 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestTotpCode(t *testing.T) {
+	// RFC 6238 Appendix B test vectors for SHA1, truncated to six digits
+	key := []byte("12345678901234567890")
+	cases := []struct {
+		Time     int64
+		Expected string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tc := range cases {
+		if code := totpCode(key, tc.Time/30); code != tc.Expected {
+			t.Errorf("time %d: expected %q, got %q", tc.Time, tc.Expected, code)
+		}
+	}
+}
+
+func TestParseIamVirtualMfaDeviceArn(t *testing.T) {
+	cases := []struct {
+		Arn          string
+		ExpectedPath string
+		ExpectedName string
+		ErrCount     int
+	}{
+		{"arn:aws:iam::123456789012:mfa/test", "/", "test", 0},
+		{"arn:aws:iam::123456789012:mfa/division/team/test", "/division/team/", "test", 0},
+		{"arn:aws:iam::123456789012:user/test", "", "", 1},
+		{"test", "", "", 1},
+	}
+
+	for _, tc := range cases {
+		path, name, err := parseIamVirtualMfaDeviceArn(tc.Arn)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Arn, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("%s: expected error", tc.Arn)
+		}
+		if path != tc.ExpectedPath || name != tc.ExpectedName {
+			t.Fatalf("%s: expected %q %q, got %q %q", tc.Arn, tc.ExpectedPath, tc.ExpectedName, path, name)
+		}
+	}
+}
+
+func TestAccAWSIAMVirtualMfaDevice_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_virtual_mfa_device.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMVirtualMfaDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMVirtualMfaDeviceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMVirtualMfaDeviceExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(`^arn:[^:]+:iam::\d{12}:mfa/%s$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "path", "/"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "virtual_mfa_device_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "base_32_string_seed"),
+					resource.TestCheckResourceAttrSet(resourceName, "qr_code_png"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"base_32_string_seed", "qr_code_png"},
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMVirtualMfaDevice_userName(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_virtual_mfa_device.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMVirtualMfaDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMVirtualMfaDeviceConfigUserName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMVirtualMfaDeviceExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "user_name", "aws_iam_user.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "enable_date"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMVirtualMfaDevice_pgpKey(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_virtual_mfa_device.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMVirtualMfaDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMVirtualMfaDeviceConfigPgpKey(rName, testPubKey1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMVirtualMfaDeviceExists(resourceName),
+					testDecryptAttributeAndTest(resourceName, "encrypted_base_32_string_seed", testPrivKey1),
+					testDecryptAttributeAndTest(resourceName, "encrypted_qr_code_png", testPrivKey1),
+					resource.TestCheckResourceAttr(resourceName, "base_32_string_seed", ""),
+					resource.TestCheckResourceAttr(resourceName, "qr_code_png", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "key_fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIAMVirtualMfaDeviceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IAM Virtual MFA Device ID is set")
+		}
+
+		device, err := testAccFindIAMVirtualMfaDevice(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if device == nil {
+			return fmt.Errorf("IAM Virtual MFA Device (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIAMVirtualMfaDeviceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_virtual_mfa_device" {
+			continue
+		}
+
+		device, err := testAccFindIAMVirtualMfaDevice(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if device != nil {
+			return fmt.Errorf("IAM Virtual MFA Device (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccFindIAMVirtualMfaDevice(serialNumber string) (*iam.VirtualMFADevice, error) {
+	conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+	var device *iam.VirtualMFADevice
+	err := conn.ListVirtualMFADevicesPages(&iam.ListVirtualMFADevicesInput{}, func(page *iam.ListVirtualMFADevicesOutput, lastPage bool) bool {
+		for _, v := range page.VirtualMFADevices {
+			if aws.StringValue(v.SerialNumber) == serialNumber {
+				device = v
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return device, err
+}
+
+func testAccAWSIAMVirtualMfaDeviceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_virtual_mfa_device" "test" {
+  virtual_mfa_device_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSIAMVirtualMfaDeviceConfigUserName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_virtual_mfa_device" "test" {
+  virtual_mfa_device_name = %[1]q
+  user_name               = "${aws_iam_user.test.name}"
+}
+`, rName)
+}
+
+func testAccAWSIAMVirtualMfaDeviceConfigPgpKey(rName, key string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_virtual_mfa_device" "test" {
+  virtual_mfa_device_name = %[1]q
+
+  pgp_key = <<EOF
+%[2]s
+EOF
+}
+`, rName, key)
+}
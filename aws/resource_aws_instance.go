@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -96,6 +97,21 @@ func resourceAwsInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"encrypted_password_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"subnet_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -124,7 +140,6 @@ func resourceAwsInstance() *schema.Resource {
 			"user_data": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"user_data_base64"},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					// Sometimes the EC2 API responds with the equivalent, empty SHA1 sum
@@ -149,7 +164,6 @@ func resourceAwsInstance() *schema.Resource {
 			"user_data_base64": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"user_data"},
 				ValidateFunc: func(v interface{}, name string) (warns []string, errs []error) {
 					s := v.(string)
@@ -162,6 +176,12 @@ func resourceAwsInstance() *schema.Resource {
 				},
 			},
 
+			"update_user_data_in_place": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"security_groups": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -241,7 +261,6 @@ func resourceAwsInstance() *schema.Resource {
 			"ebs_optimized": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
 			},
 
 			"disable_api_termination": {
@@ -288,6 +307,24 @@ func resourceAwsInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"host_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"affinity": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.AffinityDefault,
+					ec2.AffinityHost,
+				}, false),
+			},
+
 			"cpu_core_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -438,7 +475,6 @@ func resourceAwsInstance() *schema.Resource {
 							Type:             schema.TypeInt,
 							Optional:         true,
 							Computed:         true,
-							ForceNew:         true,
 							DiffSuppressFunc: iopsDiffSuppressFunc,
 						},
 
@@ -446,14 +482,12 @@ func resourceAwsInstance() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						"volume_type": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						"volume_id": {
@@ -499,6 +533,21 @@ func resourceAwsInstance() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// User data is only applied in place, with a stop and start of the
+			// instance, when explicitly asked for.
+			customdiff.ForceNewIf("user_data", func(diff *schema.ResourceDiff, meta interface{}) bool {
+				return !diff.Get("update_user_data_in_place").(bool)
+			}),
+			customdiff.ForceNewIf("user_data_base64", func(diff *schema.ResourceDiff, meta interface{}) bool {
+				return !diff.Get("update_user_data_in_place").(bool)
+			}),
+			// EBS volumes can only grow.
+			customdiff.ForceNewIfChange("root_block_device.0.volume_size", func(old, new, meta interface{}) bool {
+				return new.(int) < old.(int)
+			}),
+		),
 	}
 }
 
@@ -640,8 +689,8 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	instanceRaw, err := stateConf.WaitForState()
 	if err != nil {
 		return fmt.Errorf(
-			"Error waiting for instance (%s) to become ready: %s",
-			*instance.InstanceId, err)
+			"Error waiting for instance (%s) to become ready: %s%s",
+			*instance.InstanceId, err, awsInstanceFailureDiagnostics(conn, *instance.InstanceId))
 	}
 
 	instance = instanceRaw.(*ec2.Instance)
@@ -708,6 +757,8 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	if instance.Placement.Tenancy != nil {
 		d.Set("tenancy", instance.Placement.Tenancy)
 	}
+	d.Set("host_id", instance.Placement.HostId)
+	d.Set("affinity", instance.Placement.Affinity)
 
 	if instance.CpuOptions != nil {
 		d.Set("cpu_core_count", instance.CpuOptions.CoreCount)
@@ -882,10 +933,14 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return err
 		}
-		d.Set("password_data", passwordData)
+		if err := setPgpEncryptedAttribute(d, "password_data", passwordData, "EC2 Instance Password Data"); err != nil {
+			return err
+		}
 	} else {
 		d.Set("get_password_data", false)
 		d.Set("password_data", nil)
+		d.Set("encrypted_password_data", nil)
+		d.Set("key_fingerprint", nil)
 	}
 
 	return nil
@@ -1074,63 +1129,63 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("instance_type") && !d.IsNewResource() {
-		log.Printf("[INFO] Stopping Instance %q for instance_type change", d.Id())
-		_, err := conn.StopInstances(&ec2.StopInstancesInput{
-			InstanceIds: []*string{aws.String(d.Id())},
-		})
-		if err != nil {
-			return fmt.Errorf("error stopping instance (%s): %s", d.Id(), err)
+	if !d.IsNewResource() && resourceAwsInstanceRequiresStop(d) {
+		if err := stopAwsInstance(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
 
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{"pending", "running", "shutting-down", "stopped", "stopping"},
-			Target:     []string{"stopped"},
-			Refresh:    InstanceStateRefreshFunc(conn, d.Id(), []string{}),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
+		if d.HasChange("instance_type") {
+			log.Printf("[INFO] Modifying instance type %s", d.Id())
+			_, err := conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				InstanceType: &ec2.AttributeValue{
+					Value: aws.String(d.Get("instance_type").(string)),
+				},
+			})
+			if err != nil {
+				return err
+			}
 		}
 
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for instance (%s) to stop: %s", d.Id(), err)
+		if d.HasChange("ebs_optimized") {
+			log.Printf("[INFO] Modifying EBS optimization for instance %s", d.Id())
+			_, err := conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				EbsOptimized: &ec2.AttributeBooleanValue{
+					Value: aws.Bool(d.Get("ebs_optimized").(bool)),
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("error modifying instance (%s) EBS optimization: %s", d.Id(), err)
+			}
 		}
 
-		log.Printf("[INFO] Modifying instance type %s", d.Id())
-		_, err = conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(d.Id()),
-			InstanceType: &ec2.AttributeValue{
-				Value: aws.String(d.Get("instance_type").(string)),
-			},
-		})
-		if err != nil {
-			return err
-		}
+		if d.HasChange("user_data") || d.HasChange("user_data_base64") {
+			userData, err := resourceAwsInstanceUserData(d)
+			if err != nil {
+				return err
+			}
 
-		log.Printf("[INFO] Starting Instance %q after instance_type change", d.Id())
-		_, err = conn.StartInstances(&ec2.StartInstancesInput{
-			InstanceIds: []*string{aws.String(d.Id())},
-		})
-		if err != nil {
-			return fmt.Errorf("error starting instance (%s): %s", d.Id(), err)
+			log.Printf("[INFO] Modifying user data for instance %s", d.Id())
+			_, err = conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				UserData: &ec2.BlobAttributeValue{
+					Value: userData,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("error modifying instance (%s) user data: %s", d.Id(), err)
+			}
 		}
 
-		stateConf = &resource.StateChangeConf{
-			Pending:    []string{"pending", "stopped"},
-			Target:     []string{"running"},
-			Refresh:    InstanceStateRefreshFunc(conn, d.Id(), []string{"terminated"}),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
+		if err := startAwsInstance(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
+	}
 
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for instance (%s) to become ready: %s",
-				d.Id(), err)
+	if d.HasChange("root_block_device") && !d.IsNewResource() {
+		if err := modifyAwsInstanceRootBlockDevice(conn, d); err != nil {
+			return err
 		}
 	}
 
@@ -1250,6 +1305,89 @@ func InstanceStateRefreshFunc(conn *ec2.EC2, instanceID string, failStates []str
 	}
 }
 
+// awsInstanceConsoleOutputTailLines is the number of trailing console output
+// lines included in the diagnostics of an instance which failed to start.
+const awsInstanceConsoleOutputTailLines = 25
+
+// getAwsInstanceConsoleOutput returns the decoded console output of an
+// instance along with the time it was last updated.
+func getAwsInstanceConsoleOutput(conn *ec2.EC2, input *ec2.GetConsoleOutputInput) (string, *time.Time, error) {
+	resp, err := conn.GetConsoleOutput(input)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if resp.Output == nil {
+		return "", resp.Timestamp, nil
+	}
+
+	output, err := base64.StdEncoding.DecodeString(aws.StringValue(resp.Output))
+	if err != nil {
+		return "", nil, fmt.Errorf("error decoding console output: %s", err)
+	}
+
+	return strings.Replace(string(output), "\r\n", "\n", -1), resp.Timestamp, nil
+}
+
+// awsInstanceFailureDiagnostics returns the status checks and the tail of the
+// console output of an instance, formatted to be appended to an error. Errors
+// retrieving either are only logged, as they must not mask the original error.
+func awsInstanceFailureDiagnostics(conn *ec2.EC2, id string) string {
+	var diagnostics string
+
+	statusResp, err := conn.DescribeInstanceStatus(&ec2.DescribeInstanceStatusInput{
+		IncludeAllInstances: aws.Bool(true),
+		InstanceIds:         []*string{aws.String(id)},
+	})
+	if err != nil {
+		log.Printf("[WARN] Error describing instance (%s) status: %s", id, err)
+	} else if len(statusResp.InstanceStatuses) > 0 {
+		diagnostics += fmt.Sprintf("\n\nInstance status checks: %s", stringifyInstanceStatus(statusResp.InstanceStatuses[0]))
+	}
+
+	output, _, err := getAwsInstanceConsoleOutput(conn, &ec2.GetConsoleOutputInput{
+		InstanceId: aws.String(id),
+	})
+	if err != nil {
+		log.Printf("[WARN] Error getting instance (%s) console output: %s", id, err)
+	} else if output = tailLines(output, awsInstanceConsoleOutputTailLines); output != "" {
+		diagnostics += fmt.Sprintf("\n\nConsole output (last %d lines):\n%s", awsInstanceConsoleOutputTailLines, output)
+	}
+
+	return diagnostics
+}
+
+func stringifyInstanceStatus(status *ec2.InstanceStatus) string {
+	summarize := func(summary *ec2.InstanceStatusSummary) string {
+		if summary == nil {
+			return "unknown"
+		}
+
+		details := make([]string, 0, len(summary.Details))
+		for _, detail := range summary.Details {
+			details = append(details, fmt.Sprintf("%s: %s", aws.StringValue(detail.Name), aws.StringValue(detail.Status)))
+		}
+
+		if len(details) == 0 {
+			return aws.StringValue(summary.Status)
+		}
+
+		return fmt.Sprintf("%s (%s)", aws.StringValue(summary.Status), strings.Join(details, ", "))
+	}
+
+	return fmt.Sprintf("instance %s, system %s", summarize(status.InstanceStatus), summarize(status.SystemStatus))
+}
+
+// tailLines returns at most the last n lines of s, without trailing newlines.
+func tailLines(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func stringifyStateReason(sr *ec2.StateReason) string {
 	if sr.Message != nil {
 		return *sr.Message
@@ -1822,6 +1960,14 @@ func buildAwsInstanceOpts(
 		opts.Placement.Tenancy = aws.String(v)
 	}
 
+	if v := d.Get("host_id").(string); v != "" {
+		opts.Placement.HostId = aws.String(v)
+	}
+
+	if v := d.Get("affinity").(string); v != "" {
+		opts.Placement.Affinity = aws.String(v)
+	}
+
 	if v := d.Get("cpu_core_count").(int); v > 0 {
 		tc := d.Get("cpu_threads_per_core").(int)
 		if tc < 0 {
@@ -1945,6 +2091,152 @@ func iamInstanceProfileArnToName(ip *ec2.IamInstanceProfile) string {
 	return parts[len(parts)-1]
 }
 
+// resourceAwsInstanceRequiresStop returns whether any pending change can only
+// be applied to the instance while it is stopped.
+func resourceAwsInstanceRequiresStop(d *schema.ResourceData) bool {
+	if d.HasChange("instance_type") || d.HasChange("ebs_optimized") {
+		return true
+	}
+
+	return d.Get("update_user_data_in_place").(bool) && (d.HasChange("user_data") || d.HasChange("user_data_base64"))
+}
+
+// resourceAwsInstanceUserData returns the raw, unencoded user data configured
+// through either user_data or user_data_base64.
+func resourceAwsInstanceUserData(d *schema.ResourceData) ([]byte, error) {
+	if v := d.Get("user_data").(string); v != "" {
+		// user_data may itself already be base64 encoded, so decode exactly
+		// what would have been passed to RunInstances.
+		return base64.StdEncoding.DecodeString(base64Encode([]byte(v)))
+	}
+
+	if v := d.Get("user_data_base64").(string); v != "" {
+		userData, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding user_data_base64: %s", err)
+		}
+		return userData, nil
+	}
+
+	return []byte{}, nil
+}
+
+func stopAwsInstance(conn *ec2.EC2, id string, timeout time.Duration) error {
+	log.Printf("[INFO] Stopping Instance %q", id)
+	_, err := conn.StopInstances(&ec2.StopInstancesInput{
+		InstanceIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return fmt.Errorf("error stopping instance (%s): %s", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "running", "shutting-down", "stopped", "stopping"},
+		Target:     []string{"stopped"},
+		Refresh:    InstanceStateRefreshFunc(conn, id, []string{}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for instance (%s) to stop: %s", id, err)
+	}
+
+	return nil
+}
+
+func startAwsInstance(conn *ec2.EC2, id string, timeout time.Duration) error {
+	log.Printf("[INFO] Starting Instance %q", id)
+	_, err := conn.StartInstances(&ec2.StartInstancesInput{
+		InstanceIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return fmt.Errorf("error starting instance (%s): %s", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "stopped"},
+		Target:     []string{"running"},
+		Refresh:    InstanceStateRefreshFunc(conn, id, []string{"terminated"}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for instance (%s) to become ready: %s%s",
+			id, err, awsInstanceFailureDiagnostics(conn, id))
+	}
+
+	return nil
+}
+
+// modifyAwsInstanceRootBlockDevice applies root_block_device size, type and
+// IOPS changes to the existing root volume. Elastic Volumes modifications
+// happen online, so the instance does not need to be stopped.
+func modifyAwsInstanceRootBlockDevice(conn *ec2.EC2, d *schema.ResourceData) error {
+	volumeID := d.Get("root_block_device.0.volume_id").(string)
+	if volumeID == "" {
+		return nil
+	}
+
+	input := &ec2.ModifyVolumeInput{
+		VolumeId: aws.String(volumeID),
+	}
+	modify := false
+
+	if d.HasChange("root_block_device.0.volume_size") {
+		if v, ok := d.GetOk("root_block_device.0.volume_size"); ok {
+			input.Size = aws.Int64(int64(v.(int)))
+			modify = true
+		}
+	}
+
+	if d.HasChange("root_block_device.0.volume_type") {
+		if v, ok := d.GetOk("root_block_device.0.volume_type"); ok {
+			input.VolumeType = aws.String(v.(string))
+			modify = true
+		}
+	}
+
+	// IOPS can only be specified for io1 volumes.
+	if d.HasChange("root_block_device.0.iops") && d.Get("root_block_device.0.volume_type").(string) == ec2.VolumeTypeIo1 {
+		if v, ok := d.GetOk("root_block_device.0.iops"); ok {
+			input.Iops = aws.Int64(int64(v.(int)))
+			modify = true
+		}
+	}
+
+	if !modify {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Modifying instance (%s) root volume: %s", d.Id(), input)
+	if _, err := conn.ModifyVolume(input); err != nil {
+		return fmt.Errorf("error modifying instance (%s) root volume (%s): %s", d.Id(), volumeID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.VolumeModificationStateModifying},
+		Target:     []string{ec2.VolumeModificationStateOptimizing, ec2.VolumeModificationStateCompleted},
+		Refresh:    ebsVolumeModificationStateRefreshFunc(conn, volumeID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for instance (%s) root volume (%s) modification: %s", d.Id(), volumeID, err)
+	}
+
+	return nil
+}
+
 func userDataHashSum(user_data string) string {
 	// Check whether the user_data is not Base64 encoded.
 	// Always calculate hash of base64 decoded value since we
@@ -799,6 +799,28 @@ func TestAccAWSInstance_placementGroup(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_hostId(t *testing.T) {
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigHostId(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "affinity", "host"),
+					resource.TestCheckResourceAttrPair(resourceName, "host_id", "aws_ec2_host.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tenancy", "host"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_ipv6_supportAddressCount(t *testing.T) {
 	var v ec2.Instance
 
@@ -1320,6 +1342,96 @@ func TestAccAWSInstance_changeInstanceType(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_userDataUpdateInPlace(t *testing.T) {
+	var before ec2.Instance
+	var after ec2.Instance
+	resourceName := "aws_instance.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigUserDataInPlace("hello world"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "update_user_data_in_place", "true"),
+					resource.TestCheckResourceAttr(resourceName, "user_data_base64", "aGVsbG8gd29ybGQ="),
+				),
+			},
+			{
+				Config: testAccInstanceConfigUserDataInPlace("goodbye world"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "user_data_base64", "Z29vZGJ5ZSB3b3JsZA=="),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_ebsOptimizedUpdate(t *testing.T) {
+	var before ec2.Instance
+	var after ec2.Instance
+	resourceName := "aws_instance.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigEbsOptimized(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "ebs_optimized", "false"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigEbsOptimized(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "ebs_optimized", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_rootBlockDeviceModify(t *testing.T) {
+	var before ec2.Instance
+	var after ec2.Instance
+	resourceName := "aws_instance.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigRootBlockDeviceModify("standard", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "root_block_device.0.volume_size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "root_block_device.0.volume_type", "standard"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigRootBlockDeviceModify("gp2", 12),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "root_block_device.0.volume_size", "12"),
+					resource.TestCheckResourceAttr(resourceName, "root_block_device.0.volume_type", "gp2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_primaryNetworkInterface(t *testing.T) {
 	var instance ec2.Instance
 	var ini ec2.NetworkInterface
@@ -1621,6 +1733,30 @@ func TestAccAWSInstance_getPasswordData_trueToFalse(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_getPasswordData_pgpKey(t *testing.T) {
+	var v ec2.Instance
+	resName := "aws_instance.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_getPasswordDataPgpKey(rInt, testPubKey1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &v),
+					resource.TestCheckResourceAttr(resName, "get_password_data", "true"),
+					resource.TestCheckResourceAttr(resName, "password_data", ""),
+					resource.TestCheckResourceAttrSet(resName, "key_fingerprint"),
+					testDecryptAttributeAndTest(resName, "encrypted_password_data", testPrivKey1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_creditSpecification_unspecifiedDefaultsToStandard(t *testing.T) {
 	var instance ec2.Instance
 	resName := "aws_instance.foo"
@@ -2124,6 +2260,52 @@ func TestInstanceCpuThreadsPerCoreSchema(t *testing.T) {
 	}
 }
 
+func TestTailLines(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Lines    int
+		Expected string
+	}{
+		{"", 3, ""},
+		{"one\ntwo\n", 3, "one\ntwo"},
+		{"one\ntwo\nthree\nfour\n\n", 2, "three\nfour"},
+		{"one", 1, "one"},
+	}
+
+	for _, tc := range cases {
+		if actual := tailLines(tc.Input, tc.Lines); actual != tc.Expected {
+			t.Errorf("tailLines(%q, %d) = %q, expected %q", tc.Input, tc.Lines, actual, tc.Expected)
+		}
+	}
+}
+
+func TestStringifyInstanceStatus(t *testing.T) {
+	status := &ec2.InstanceStatus{
+		InstanceStatus: &ec2.InstanceStatusSummary{
+			Details: []*ec2.InstanceStatusDetails{
+				{
+					Name:   aws.String(ec2.StatusNameReachability),
+					Status: aws.String(ec2.StatusTypeFailed),
+				},
+			},
+			Status: aws.String(ec2.SummaryStatusImpaired),
+		},
+		SystemStatus: &ec2.InstanceStatusSummary{
+			Status: aws.String(ec2.SummaryStatusOk),
+		},
+	}
+
+	expected := "instance impaired (reachability: failed), system ok"
+	if actual := stringifyInstanceStatus(status); actual != expected {
+		t.Fatalf("Got %q, expected %q", actual, expected)
+	}
+
+	expected = "instance unknown, system unknown"
+	if actual := stringifyInstanceStatus(&ec2.InstanceStatus{}); actual != expected {
+		t.Fatalf("Got %q, expected %q", actual, expected)
+	}
+}
+
 func driftTags(instance *ec2.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).ec2conn
@@ -2343,6 +2525,63 @@ resource "aws_instance" "foo" {
 }
 `
 
+func testAccInstanceConfigUserDataInPlace(userData string) string {
+	return fmt.Sprintf(`
+resource "aws_instance" "foo" {
+	# us-west-2
+	ami = "ami-55a7ea65"
+	availability_zone = "us-west-2a"
+
+	instance_type = "m3.medium"
+
+	update_user_data_in_place = true
+	user_data_base64 = "${base64encode(%q)}"
+
+	tags {
+	    Name = "tf-acctest"
+	}
+}
+`, userData)
+}
+
+func testAccInstanceConfigEbsOptimized(ebsOptimized bool) string {
+	return fmt.Sprintf(`
+resource "aws_instance" "foo" {
+	# us-west-2
+	ami = "ami-55a7ea65"
+	availability_zone = "us-west-2a"
+
+	instance_type = "m3.large"
+	ebs_optimized = %t
+
+	tags {
+	    Name = "tf-acctest"
+	}
+}
+`, ebsOptimized)
+}
+
+func testAccInstanceConfigRootBlockDeviceModify(volumeType string, volumeSize int) string {
+	return fmt.Sprintf(`
+resource "aws_instance" "foo" {
+	# us-west-2
+	ami = "ami-55a7ea65"
+	availability_zone = "us-west-2a"
+
+	instance_type = "m3.medium"
+
+	root_block_device {
+		volume_type = %q
+		volume_size = %d
+	}
+
+	tags {
+	    Name = "tf-acctest"
+	}
+}
+`, volumeType, volumeSize)
+}
+
 const testAccInstanceGP2IopsDevice = `
 resource "aws_instance" "foo" {
 	# us-west-2
@@ -3708,6 +3947,72 @@ func testAccInstanceConfig_getPasswordData(val bool, rInt int) string {
 	`, rInt, val)
 }
 
+func testAccInstanceConfigHostId() string {
+	return `
+data "aws_availability_zones" "available" {}
+
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_ec2_host" "test" {
+  auto_placement    = "off"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_type     = "m5.large"
+}
+
+resource "aws_instance" "test" {
+  affinity          = "host"
+  ami               = "${data.aws_ami.test.id}"
+  availability_zone = "${aws_ec2_host.test.availability_zone}"
+  host_id           = "${aws_ec2_host.test.id}"
+  instance_type     = "m5.large"
+  tenancy           = "host"
+}
+`
+}
+
+func testAccInstanceConfig_getPasswordDataPgpKey(rInt int, key string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "win2016core" {
+  most_recent = true
+
+  filter {
+    name   = "owner-alias"
+    values = ["amazon"]
+  }
+
+  filter {
+    name   = "name"
+    values = ["Windows_Server-2016-English-Core-Base-*"]
+  }
+}
+
+resource "aws_key_pair" "foo" {
+  key_name   = "tf-acctest-%d"
+  public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAABJQAAAQEAq6U3HQYC4g8WzU147gZZ7CKQH8TgYn3chZGRPxaGmHW1RUwsyEs0nmombmIhwxudhJ4ehjqXsDLoQpd6+c7BuLgTMvbv8LgE9LX53vnljFe1dsObsr/fYLvpU9LTlo8HgHAqO5ibNdrAUvV31ronzCZhms/Gyfdaue88Fd0/YnsZVGeOZPayRkdOHSpqme2CBrpa8myBeL1CWl0LkDG4+YCURjbaelfyZlIApLYKy3FcCan9XQFKaL32MJZwCgzfOvWIMtYcU8QtXMgnA3/I3gXk8YDUJv5P4lj0s/PJXuTM8DygVAUtebNwPuinS7wwonm5FXcWMuVGsVpG5K7FGQ== tf-acc-winpasswordtest"
+}
+
+resource "aws_instance" "foo" {
+  ami           = "${data.aws_ami.win2016core.id}"
+  instance_type = "t2.medium"
+  key_name      = "${aws_key_pair.foo.key_name}"
+
+  get_password_data = true
+
+  pgp_key = <<EOF
+%s
+EOF
+}
+`, rInt, key)
+}
+
 func testAccInstanceConfig_creditSpecification_unspecified(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "my_vpc" {
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,9 +17,10 @@ func resourceAwsIotCertificate() *schema.Resource {
 		Delete: resourceAwsIotCertificateDelete,
 		Schema: map[string]*schema.Schema{
 			"csr": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"pgp_key"},
 			},
 			"active": {
 				Type:     schema.TypeBool,
@@ -28,6 +30,32 @@ func resourceAwsIotCertificate() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"certificate_pem": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_private_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -35,20 +63,44 @@ func resourceAwsIotCertificate() *schema.Resource {
 func resourceAwsIotCertificateCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iotconn
 
-	log.Printf("[DEBUG] Creating certificate from csr")
-	out, err := conn.CreateCertificateFromCsr(&iot.CreateCertificateFromCsrInput{
-		CertificateSigningRequest: aws.String(d.Get("csr").(string)),
-		SetAsActive:               aws.Bool(d.Get("active").(bool)),
+	if v, ok := d.GetOk("csr"); ok {
+		log.Printf("[DEBUG] Creating certificate from csr")
+		out, err := conn.CreateCertificateFromCsr(&iot.CreateCertificateFromCsrInput{
+			CertificateSigningRequest: aws.String(v.(string)),
+			SetAsActive:               aws.Bool(d.Get("active").(bool)),
+		})
+
+		if err != nil {
+			log.Printf("[ERROR] %s", err)
+			return err
+		}
+		log.Printf("[DEBUG] Created certificate from csr")
+
+		d.SetId(*out.CertificateId)
+
+		return resourceAwsIotCertificateRead(d, meta)
+	}
+
+	log.Printf("[DEBUG] Creating keys and certificate")
+	out, err := conn.CreateKeysAndCertificate(&iot.CreateKeysAndCertificateInput{
+		SetAsActive: aws.Bool(d.Get("active").(bool)),
 	})
 
 	if err != nil {
-		log.Printf("[ERROR] %s", err)
-		return err
+		return fmt.Errorf("error creating IoT keys and certificate: %s", err)
 	}
-	log.Printf("[DEBUG] Created certificate from csr")
+	log.Printf("[DEBUG] Created keys and certificate")
 
 	d.SetId(*out.CertificateId)
 
+	// The key pair is only available in the response from CreateKeysAndCertificate
+	if out.KeyPair != nil {
+		d.Set("public_key", out.KeyPair.PublicKey)
+		if err := setPgpEncryptedAttribute(d, "private_key", aws.StringValue(out.KeyPair.PrivateKey), "IoT Certificate Private Key"); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsIotCertificateRead(d, meta)
 }
 
@@ -66,6 +118,7 @@ func resourceAwsIotCertificateRead(d *schema.ResourceData, meta interface{}) err
 
 	d.Set("active", aws.Bool(*out.CertificateDescription.Status == iot.CertificateStatusActive))
 	d.Set("arn", out.CertificateDescription.CertificateArn)
+	d.Set("certificate_pem", out.CertificateDescription.CertificatePem)
 
 	return nil
 }
@@ -29,6 +29,48 @@ func TestAccAWSIoTCertificate_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSIoTCertificate_keysAndCertificate(t *testing.T) {
+	resourceName := "aws_iot_certificate.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIoTCertificateDestroy_basic,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIoTCertificate_keysAndCertificate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_pem"),
+					resource.TestCheckResourceAttrSet(resourceName, "public_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIoTCertificate_pgpKey(t *testing.T) {
+	resourceName := "aws_iot_certificate.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIoTCertificateDestroy_basic,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIoTCertificateConfigPgpKey(testPubKey1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "private_key", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "key_fingerprint"),
+					testDecryptAttributeAndTest(resourceName, "encrypted_private_key", testPrivKey1),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSIoTCertificateDestroy_basic(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).iotconn
 
@@ -69,3 +111,21 @@ resource "aws_iot_certificate" "foo_cert" {
   active = true
 }
 `
+
+var testAccAWSIoTCertificate_keysAndCertificate = `
+resource "aws_iot_certificate" "test" {
+  active = true
+}
+`
+
+func testAccAWSIoTCertificateConfigPgpKey(key string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_certificate" "test" {
+  active = true
+
+  pgp_key = <<EOF
+%s
+EOF
+}
+`, key)
+}
@@ -0,0 +1,350 @@
+package aws
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsKmsExternalKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKmsExternalKeyCreate,
+		Read:   resourceAwsKmsExternalKeyRead,
+		Update: resourceAwsKmsExternalKeyUpdate,
+		Delete: resourceAwsKmsKeyDelete,
+		Exists: resourceAwsKmsKeyExists,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"deletion_window_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(7, 30),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"expiration_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_material_base64": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_usage": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"tags": tagsSchema(),
+			"valid_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339TimeString,
+			},
+		},
+	}
+}
+
+func resourceAwsKmsExternalKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	req := &kms.CreateKeyInput{
+		KeyUsage: aws.String(kms.KeyUsageTypeEncryptDecrypt),
+		Origin:   aws.String(kms.OriginTypeExternal),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("policy"); ok {
+		req.Policy = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		req.Tags = tagsFromMapKMS(v.(map[string]interface{}))
+	}
+
+	var resp *kms.CreateKeyOutput
+	// AWS requires any principal in the policy to exist before the key is created.
+	err := resource.Retry(30*time.Second, func() *resource.RetryError {
+		var err error
+		resp, err = conn.CreateKey(req)
+		if isAWSErr(err, kms.ErrCodeMalformedPolicyDocumentException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating KMS External Key: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.KeyMetadata.KeyId))
+	d.Set("key_id", resp.KeyMetadata.KeyId)
+
+	if v, ok := d.GetOk("key_material_base64"); ok {
+		if err := importKmsExternalKeyMaterial(conn, d.Id(), v.(string), d.Get("valid_to").(string)); err != nil {
+			return err
+		}
+
+		if err := waitForKmsKeyState(conn, d.Id(), kms.KeyStateEnabled); err != nil {
+			return err
+		}
+
+		// Imported keys are enabled, so only disable if asked to
+		if v, ok := d.GetOkExists("enabled"); ok && !v.(bool) {
+			if err := updateKmsKeyStatus(conn, d.Id(), false); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsKmsExternalKeyRead(d, meta)
+}
+
+func resourceAwsKmsExternalKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	req := &kms.DescribeKeyInput{
+		KeyId: aws.String(d.Id()),
+	}
+
+	var resp *kms.DescribeKeyOutput
+	var err error
+	if d.IsNewResource() {
+		var out interface{}
+		out, err = retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+			return conn.DescribeKey(req)
+		})
+		resp, _ = out.(*kms.DescribeKeyOutput)
+	} else {
+		resp, err = conn.DescribeKey(req)
+	}
+	if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] KMS External Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading KMS External Key (%s): %s", d.Id(), err)
+	}
+	metadata := resp.KeyMetadata
+
+	if aws.StringValue(metadata.KeyState) == kms.KeyStatePendingDeletion {
+		log.Printf("[WARN] Removing KMS External Key %s because it's already gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if origin := aws.StringValue(metadata.Origin); origin != kms.OriginTypeExternal {
+		return fmt.Errorf("KMS Key (%s) has origin %q, expected %q", d.Id(), origin, kms.OriginTypeExternal)
+	}
+
+	d.Set("arn", metadata.Arn)
+	d.Set("key_id", metadata.KeyId)
+	d.Set("description", metadata.Description)
+	d.Set("enabled", metadata.Enabled)
+	d.Set("expiration_model", metadata.ExpirationModel)
+	d.Set("key_state", metadata.KeyState)
+	d.Set("key_usage", metadata.KeyUsage)
+
+	d.Set("valid_to", "")
+	if metadata.ValidTo != nil {
+		d.Set("valid_to", aws.TimeValue(metadata.ValidTo).Format(time.RFC3339))
+	}
+
+	policy, err := getKmsKeyPolicy(conn, metadata.KeyId)
+	if err != nil {
+		return err
+	}
+	d.Set("policy", policy)
+
+	tags, err := getKmsKeyTags(conn, metadata.KeyId)
+	if err != nil {
+		return err
+	}
+	d.Set("tags", tagsToMapKMS(tags))
+
+	return nil
+}
+
+func resourceAwsKmsExternalKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	// Key material which has expired or was deleted must be re-imported,
+	// as must the same key material with a new expiration date.
+	if v, ok := d.GetOk("key_material_base64"); ok {
+		if d.HasChange("valid_to") || d.Get("key_state").(string) == kms.KeyStatePendingImport {
+			if err := importKmsExternalKeyMaterial(conn, d.Id(), v.(string), d.Get("valid_to").(string)); err != nil {
+				return err
+			}
+
+			if err := waitForKmsKeyState(conn, d.Id(), kms.KeyStateEnabled, kms.KeyStateDisabled); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("enabled") && d.Get("enabled").(bool) {
+		// Enable before any attributes will be modified
+		if err := updateKmsKeyStatus(conn, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("description") {
+		if err := resourceAwsKmsKeyDescriptionUpdate(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("policy") {
+		if err := resourceAwsKmsKeyPolicyUpdate(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("enabled") && !d.Get("enabled").(bool) {
+		// Only disable when all attributes are modified
+		// because we cannot modify disabled keys
+		if err := updateKmsKeyStatus(conn, d.Id(), false); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsKMS(conn, d, d.Id()); err != nil {
+		return err
+	}
+
+	return resourceAwsKmsExternalKeyRead(d, meta)
+}
+
+// importKmsExternalKeyMaterial wraps the base64 encoded key material with a
+// fresh import public key and imports it into the given key.
+func importKmsExternalKeyMaterial(conn *kms.KMS, keyId, keyMaterialBase64, validTo string) error {
+	keyMaterial, err := base64.StdEncoding.DecodeString(keyMaterialBase64)
+	if err != nil {
+		return fmt.Errorf("error decoding key_material_base64: %s", err)
+	}
+
+	log.Printf("[DEBUG] Getting parameters for import of KMS External Key (%s)", keyId)
+	out, err := retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+		return conn.GetParametersForImport(&kms.GetParametersForImportInput{
+			KeyId:             aws.String(keyId),
+			WrappingAlgorithm: aws.String(kms.AlgorithmSpecRsaesOaepSha256),
+			WrappingKeySpec:   aws.String(kms.WrappingKeySpecRsa2048),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error getting parameters for import of KMS External Key (%s): %s", keyId, err)
+	}
+	params := out.(*kms.GetParametersForImportOutput)
+
+	parsedPublicKey, err := x509.ParsePKIXPublicKey(params.PublicKey)
+	if err != nil {
+		return fmt.Errorf("error parsing import public key for KMS External Key (%s): %s", keyId, err)
+	}
+	publicKey, ok := parsedPublicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("import public key for KMS External Key (%s) is not an RSA key", keyId)
+	}
+
+	encryptedKeyMaterial, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, publicKey, keyMaterial, []byte{})
+	if err != nil {
+		return fmt.Errorf("error encrypting key material for KMS External Key (%s): %s", keyId, err)
+	}
+
+	input := &kms.ImportKeyMaterialInput{
+		EncryptedKeyMaterial: encryptedKeyMaterial,
+		ExpirationModel:      aws.String(kms.ExpirationModelTypeKeyMaterialDoesNotExpire),
+		ImportToken:          params.ImportToken,
+		KeyId:                aws.String(keyId),
+	}
+
+	if validTo != "" {
+		t, err := time.Parse(time.RFC3339, validTo)
+		if err != nil {
+			return fmt.Errorf("error parsing valid_to: %s", err)
+		}
+		input.ExpirationModel = aws.String(kms.ExpirationModelTypeKeyMaterialExpires)
+		input.ValidTo = aws.Time(t)
+	}
+
+	log.Printf("[DEBUG] Importing key material into KMS External Key (%s)", keyId)
+	_, err = retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+		return conn.ImportKeyMaterial(input)
+	})
+	if err != nil {
+		return fmt.Errorf("error importing key material into KMS External Key (%s): %s", keyId, err)
+	}
+
+	return nil
+}
+
+func waitForKmsKeyState(conn *kms.KMS, keyId string, target ...string) error {
+	wait := resource.StateChangeConf{
+		Pending:    []string{kms.KeyStatePendingImport},
+		Target:     target,
+		Timeout:    5 * time.Minute,
+		MinTimeout: 2 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeKey(&kms.DescribeKeyInput{
+				KeyId: aws.String(keyId),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			return resp, aws.StringValue(resp.KeyMetadata.KeyState), nil
+		},
+	}
+
+	if _, err := wait.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for KMS Key (%s) state: %s", keyId, err)
+	}
+
+	return nil
+}
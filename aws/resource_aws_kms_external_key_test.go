@@ -0,0 +1,296 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// 256-bit key material for testing only
+const testAccAWSKmsExternalKeyMaterial = "Wblj06fduthWggmsT0cLVoIMOkeLbc2kVfMud77i/JY="
+
+func TestAccAWSKmsExternalKey_basic(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test-kms-key")
+	resourceName := "aws_kms_external_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", ""),
+					resource.TestCheckResourceAttr(resourceName, "key_state", kms.KeyStatePendingImport),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", kms.KeyUsageTypeEncryptDecrypt),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days"},
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsExternalKey_keyMaterial(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test-kms-key")
+	resourceName := "aws_kms_external_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfigKeyMaterial(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					testAccCheckAWSKmsKeyIsEnabled(&key, true),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", kms.ExpirationModelTypeKeyMaterialDoesNotExpire),
+					resource.TestCheckResourceAttr(resourceName, "key_state", kms.KeyStateEnabled),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "key_material_base64"},
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsExternalKey_validTo(t *testing.T) {
+	var key1, key2 kms.KeyMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test-kms-key")
+	resourceName := "aws_kms_external_key.test"
+	validTo1 := time.Now().UTC().Add(1 * time.Hour).Format(time.RFC3339)
+	validTo2 := time.Now().UTC().Add(2 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfigKeyMaterial(rName, validTo1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key1),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", kms.ExpirationModelTypeKeyMaterialExpires),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", validTo1),
+				),
+			},
+			{
+				Config: testAccAWSKmsExternalKeyConfigKeyMaterial(rName, validTo2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key2),
+					testAccCheckAWSKmsExternalKeyNotRecreated(&key1, &key2),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", kms.ExpirationModelTypeKeyMaterialExpires),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", validTo2),
+				),
+			},
+			{
+				Config: testAccAWSKmsExternalKeyConfigKeyMaterial(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", kms.ExpirationModelTypeKeyMaterialDoesNotExpire),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsExternalKey_enabled(t *testing.T) {
+	var key1, key2, key3 kms.KeyMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test-kms-key")
+	resourceName := "aws_kms_external_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfigEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key1),
+					testAccCheckAWSKmsKeyIsEnabled(&key1, false),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAWSKmsExternalKeyConfigEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key2),
+					testAccCheckAWSKmsKeyIsEnabled(&key2, true),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSKmsExternalKeyConfigEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key3),
+					testAccCheckAWSKmsKeyIsEnabled(&key3, false),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsExternalKey_policyAndTags(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test-kms-key")
+	resourceName := "aws_kms_external_key.test"
+	expectedPolicyText := `{"Version":"2012-10-17","Id":"kms-tf-1","Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:*","Resource":"*"}]}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfigPolicyAndTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					testAccCheckAWSKmsKeyHasPolicy(resourceName, expectedPolicyText),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.Usage", "byok"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKmsExternalKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_external_key" {
+			continue
+		}
+
+		out, err := conn.DescribeKey(&kms.DescribeKeyInput{
+			KeyId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(out.KeyMetadata.KeyState) == kms.KeyStatePendingDeletion {
+			continue
+		}
+
+		return fmt.Errorf("KMS External Key still exists:\n%#v", out.KeyMetadata)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSKmsExternalKeyNotRecreated(i, j *kms.KeyMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.KeyId) != aws.StringValue(j.KeyId) {
+			return fmt.Errorf("KMS External Key recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSKmsExternalKeyConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  deletion_window_in_days = 7
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSKmsExternalKeyConfigKeyMaterial(rName, validTo string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  deletion_window_in_days = 7
+  key_material_base64     = %[2]q
+  valid_to                = %[3]q
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName, testAccAWSKmsExternalKeyMaterial, validTo)
+}
+
+func testAccAWSKmsExternalKeyConfigEnabled(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  deletion_window_in_days = 7
+  enabled                 = %[3]t
+  key_material_base64     = %[2]q
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName, testAccAWSKmsExternalKeyMaterial, enabled)
+}
+
+func testAccAWSKmsExternalKeyConfigPolicyAndTags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  deletion_window_in_days = 7
+  description             = %[1]q
+  key_material_base64     = %[2]q
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Id": "kms-tf-1",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "*"
+      },
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+
+  tags {
+    Name  = %[1]q
+    Usage = "byok"
+  }
+}
+`, rName, testAccAWSKmsExternalKeyMaterial)
+}
@@ -155,21 +155,10 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("key_usage", metadata.KeyUsage)
 	d.Set("is_enabled", metadata.Enabled)
 
-	pOut, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
-		return conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
-			KeyId:      metadata.KeyId,
-			PolicyName: aws.String("default"),
-		})
-	})
+	policy, err := getKmsKeyPolicy(conn, metadata.KeyId)
 	if err != nil {
 		return err
 	}
-
-	p := pOut.(*kms.GetKeyPolicyOutput)
-	policy, err := structure.NormalizeJsonString(*p.Policy)
-	if err != nil {
-		return fmt.Errorf("policy contains an invalid JSON: %s", err)
-	}
 	d.Set("policy", policy)
 
 	out, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
@@ -183,18 +172,46 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	krs, _ := out.(*kms.GetKeyRotationStatusOutput)
 	d.Set("enable_key_rotation", krs.KeyRotationEnabled)
 
+	tags, err := getKmsKeyTags(conn, metadata.KeyId)
+	if err != nil {
+		return err
+	}
+	d.Set("tags", tagsToMapKMS(tags))
+
+	return nil
+}
+
+func getKmsKeyPolicy(conn *kms.KMS, keyId *string) (string, error) {
+	pOut, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+		return conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
+			KeyId:      keyId,
+			PolicyName: aws.String("default"),
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	p := pOut.(*kms.GetKeyPolicyOutput)
+	policy, err := structure.NormalizeJsonString(*p.Policy)
+	if err != nil {
+		return "", fmt.Errorf("policy contains an invalid JSON: %s", err)
+	}
+
+	return policy, nil
+}
+
+func getKmsKeyTags(conn *kms.KMS, keyId *string) ([]*kms.Tag, error) {
 	tOut, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
 		return conn.ListResourceTags(&kms.ListResourceTagsInput{
-			KeyId: metadata.KeyId,
+			KeyId: keyId,
 		})
 	})
 	if err != nil {
-		return fmt.Errorf("Failed to get KMS key tags (key: %s): %s", d.Get("key_id").(string), err)
+		return nil, fmt.Errorf("Failed to get KMS key tags (key: %s): %s", aws.StringValue(keyId), err)
 	}
-	tagList := tOut.(*kms.ListResourceTagsOutput)
-	d.Set("tags", tagsToMapKMS(tagList.Tags))
 
-	return nil
+	return tOut.(*kms.ListResourceTagsOutput).Tags, nil
 }
 
 func resourceAwsKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -445,7 +462,7 @@ func resourceAwsKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 
 	// Wait for propagation since KMS is eventually consistent
 	wait := resource.StateChangeConf{
-		Pending:                   []string{"Enabled", "Disabled"},
+		Pending:                   []string{"Enabled", "Disabled", "PendingImport"},
 		Target:                    []string{"PendingDeletion"},
 		Timeout:                   20 * time.Minute,
 		MinTimeout:                2 * time.Second,
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -56,8 +57,17 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 			},
 
 			"default_version": {
-				Type:     schema.TypeInt,
-				Computed: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"update_default_version"},
+				ValidateFunc:  validation.IntAtLeast(1),
+			},
+
+			"update_default_version": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"default_version"},
 			},
 
 			"latest_version": {
@@ -65,6 +75,12 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 				Computed: true,
 			},
 
+			"retain_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
 			"block_device_mappings": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -360,6 +376,10 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 						"affinity": {
 							Type:     schema.TypeString,
 							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.AffinityDefault,
+								ec2.AffinityHost,
+							}, false),
 						},
 						"availability_zone": {
 							Type:     schema.TypeString,
@@ -436,21 +456,35 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
-			customdiff.ComputedIf("latest_version", func(diff *schema.ResourceDiff, meta interface{}) bool {
-				for _, changedKey := range diff.GetChangedKeysPrefix("") {
-					switch changedKey {
-					case "name", "name_prefix", "description", "default_version", "latest_version":
-						continue
-					default:
-						return true
-					}
-				}
-				return false
+			customdiff.ComputedIf("latest_version", launchTemplateDiffRequiresNewVersion),
+			customdiff.ComputedIf("default_version", func(diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.Get("update_default_version").(bool) && launchTemplateDiffRequiresNewVersion(diff, meta)
 			}),
 		),
 	}
 }
 
+// launchTemplateVersionIndependentAttributes are the attributes which can be
+// changed without creating a new launch template version.
+var launchTemplateVersionIndependentAttributes = map[string]bool{
+	"name":                   true,
+	"name_prefix":            true,
+	"description":            true,
+	"default_version":        true,
+	"update_default_version": true,
+	"latest_version":         true,
+	"retain_versions":        true,
+}
+
+func launchTemplateDiffRequiresNewVersion(diff *schema.ResourceDiff, meta interface{}) bool {
+	for _, changedKey := range diff.GetChangedKeysPrefix("") {
+		if !launchTemplateVersionIndependentAttributes[strings.Split(changedKey, ".")[0]] {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -603,7 +637,7 @@ func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) err
 func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
-	if !d.IsNewResource() {
+	if !d.IsNewResource() && launchTemplateDataHasChange(d) {
 		launchTemplateData, err := buildLaunchTemplateData(d, meta)
 		if err != nil {
 			return err
@@ -615,20 +649,42 @@ func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) e
 			LaunchTemplateData: launchTemplateData,
 		}
 
-		_, createErr := conn.CreateLaunchTemplateVersion(launchTemplateVersionOpts)
+		resp, createErr := conn.CreateLaunchTemplateVersion(launchTemplateVersionOpts)
 		if createErr != nil {
 			return createErr
 		}
+
+		if d.Get("update_default_version").(bool) {
+			version := aws.Int64Value(resp.LaunchTemplateVersion.VersionNumber)
+			if err := setLaunchTemplateDefaultVersion(conn, d.Id(), version); err != nil {
+				return err
+			}
+		}
 	}
 
 	d.Partial(true)
 
+	if d.HasChange("default_version") && !d.Get("update_default_version").(bool) {
+		if v, ok := d.GetOk("default_version"); ok {
+			if err := setLaunchTemplateDefaultVersion(conn, d.Id(), int64(v.(int))); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("default_version")
+	}
+
 	if err := setTags(conn, d); err != nil {
 		return err
 	} else {
 		d.SetPartial("tags")
 	}
 
+	if v, ok := d.GetOk("retain_versions"); ok {
+		if err := deleteLaunchTemplateOldVersions(conn, d.Id(), v.(int)); err != nil {
+			return err
+		}
+	}
+
 	d.Partial(false)
 
 	return resourceAwsLaunchTemplateRead(d, meta)
@@ -652,6 +708,93 @@ func resourceAwsLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func launchTemplateDataHasChange(d *schema.ResourceData) bool {
+	for k := range resourceAwsLaunchTemplate().Schema {
+		if !launchTemplateVersionIndependentAttributes[k] && d.HasChange(k) {
+			return true
+		}
+	}
+	return false
+}
+
+func setLaunchTemplateDefaultVersion(conn *ec2.EC2, id string, version int64) error {
+	log.Printf("[DEBUG] Setting Launch Template (%s) default version: %d", id, version)
+	_, err := conn.ModifyLaunchTemplate(&ec2.ModifyLaunchTemplateInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		DefaultVersion:   aws.String(strconv.FormatInt(version, 10)),
+		LaunchTemplateId: aws.String(id),
+	})
+	if err != nil {
+		return fmt.Errorf("error setting Launch Template (%s) default version: %s", id, err)
+	}
+
+	return nil
+}
+
+// deleteLaunchTemplateOldVersions deletes all but the most recent retain
+// versions of a launch template. The default version is never deleted.
+func deleteLaunchTemplateOldVersions(conn *ec2.EC2, id string, retain int) error {
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(id),
+	}
+
+	var versions []*ec2.LaunchTemplateVersion
+	for {
+		resp, err := conn.DescribeLaunchTemplateVersions(input)
+		if err != nil {
+			return fmt.Errorf("error listing Launch Template (%s) versions: %s", id, err)
+		}
+
+		versions = append(versions, resp.LaunchTemplateVersions...)
+
+		if aws.StringValue(resp.NextToken) == "" {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return aws.Int64Value(versions[i].VersionNumber) > aws.Int64Value(versions[j].VersionNumber)
+	})
+
+	var expired []*string
+	for i, version := range versions {
+		if i < retain || aws.BoolValue(version.DefaultVersion) {
+			continue
+		}
+		expired = append(expired, aws.String(strconv.FormatInt(aws.Int64Value(version.VersionNumber), 10)))
+	}
+
+	// At most 200 versions can be deleted per request.
+	for len(expired) > 0 {
+		n := len(expired)
+		if n > 200 {
+			n = 200
+		}
+
+		log.Printf("[DEBUG] Deleting Launch Template (%s) versions: %s", id, aws.StringValueSlice(expired[:n]))
+		resp, err := conn.DeleteLaunchTemplateVersions(&ec2.DeleteLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(id),
+			Versions:         expired[:n],
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting Launch Template (%s) versions: %s", id, err)
+		}
+
+		for _, item := range resp.UnsuccessfullyDeletedLaunchTemplateVersions {
+			if item == nil || item.ResponseError == nil {
+				continue
+			}
+			return fmt.Errorf("error deleting Launch Template (%s) version %d: %s: %s", id, aws.Int64Value(item.VersionNumber),
+				aws.StringValue(item.ResponseError.Code), aws.StringValue(item.ResponseError.Message))
+		}
+
+		expired = expired[n:]
+	}
+
+	return nil
+}
+
 func getBlockDeviceMappings(m []*ec2.LaunchTemplateBlockDeviceMapping) []interface{} {
 	s := []interface{}{}
 	for _, v := range m {
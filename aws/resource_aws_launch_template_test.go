@@ -3,6 +3,8 @@ package aws
 import (
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -220,6 +222,119 @@ func TestAccAWSLaunchTemplate_update(t *testing.T) {
 	})
 }
 
+func TestAccAWSLaunchTemplate_updateDefaultVersion(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_versions(rInt, "t2.micro", "update_default_version = true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_versions(rInt, "t2.small", "update_default_version = true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "2"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_defaultVersion(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_versions(rInt, "t2.micro", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_versions(rInt, "t2.small", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "2"),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_versions(rInt, "t2.small", "default_version = 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "2"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_retainVersions(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_versions(rInt, "t2.micro", "retain_versions = 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					testAccCheckAWSLaunchTemplateVersions(resName, []int64{1}),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_versions(rInt, "t2.small", "retain_versions = 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					testAccCheckAWSLaunchTemplateVersions(resName, []int64{1, 2}),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_versions(rInt, "t2.medium", "retain_versions = 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "latest_version", "3"),
+					// The default version is always retained.
+					testAccCheckAWSLaunchTemplateVersions(resName, []int64{1, 2, 3}),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_versions(rInt, "t2.large", "retain_versions = 2\n  update_default_version = true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "4"),
+					testAccCheckAWSLaunchTemplateVersions(resName, []int64{3, 4}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSLaunchTemplate_tags(t *testing.T) {
 	var template ec2.LaunchTemplate
 	resName := "aws_launch_template.foo"
@@ -313,6 +428,30 @@ func TestAccAWSLaunchTemplate_creditSpecification_t3(t *testing.T) {
 	})
 }
 
+func TestAccAWSLaunchTemplate_placement_hostId(t *testing.T) {
+	var template ec2.LaunchTemplate
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resName := "aws_launch_template.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_placementHostId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "placement.#", "1"),
+					resource.TestCheckResourceAttr(resName, "placement.0.affinity", "host"),
+					resource.TestCheckResourceAttrPair(resName, "placement.0.host_id", "aws_ec2_host.test", "id"),
+					resource.TestCheckResourceAttr(resName, "placement.0.tenancy", "host"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSLaunchTemplate_networkInterface(t *testing.T) {
 	var template ec2.LaunchTemplate
 	resName := "aws_launch_template.test"
@@ -409,6 +548,36 @@ func testAccCheckAWSLaunchTemplateExists(n string, t *ec2.LaunchTemplate) resour
 	}
 }
 
+func testAccCheckAWSLaunchTemplateVersions(n string, expected []int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		resp, err := conn.DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		actual := make([]int64, 0, len(resp.LaunchTemplateVersions))
+		for _, version := range resp.LaunchTemplateVersions {
+			actual = append(actual, aws.Int64Value(version.VersionNumber))
+		}
+		sort.Slice(actual, func(i, j int) bool { return actual[i] < actual[j] })
+
+		if !reflect.DeepEqual(actual, expected) {
+			return fmt.Errorf("Expected Launch Template versions %v, got %v", expected, actual)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSLaunchTemplateDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -449,6 +618,16 @@ resource "aws_launch_template" "foo" {
 `, rInt)
 }
 
+func testAccAWSLaunchTemplateConfig_versions(rInt int, instanceType, extra string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name          = "versions_foo_%d"
+  instance_type = %q
+  %s
+}
+`, rInt, instanceType, extra)
+}
+
 func testAccAWSLaunchTemplateConfig_ipv6_count(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_launch_template" "foo" {
@@ -647,6 +826,28 @@ resource "aws_launch_template" "foo" {
 `, instanceType, rName, cpuCredits)
 }
 
+func testAccAWSLaunchTemplateConfig_placementHostId(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_ec2_host" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_type     = "m5.large"
+}
+
+resource "aws_launch_template" "foo" {
+  instance_type = "m5.large"
+  name          = %q
+
+  placement {
+    affinity = "host"
+    host_id  = "${aws_ec2_host.test.id}"
+    tenancy  = "host"
+  }
+}
+`, rName)
+}
+
 const testAccAWSLaunchTemplateConfig_networkInterface = `
 resource "aws_vpc" "test" {
   cidr_block = "10.1.0.0/16"
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/lightsail"
-	"github.com/hashicorp/terraform/helper/encryption"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -116,12 +115,8 @@ func resourceAwsLightsailKeyPairCreate(d *schema.ResourceData, meta interface{})
 		d.Set("public_key", resp.PublicKeyBase64)
 
 		// encrypt private key if pgp_key is given
-		pgpKey, err := encryption.RetrieveGPGKey(d.Get("pgp_key").(string))
-		if err != nil {
-			return err
-		}
-		if pgpKey != "" {
-			fingerprint, encrypted, err := encryption.EncryptValue(pgpKey, *resp.PrivateKeyBase64, "Lightsail Private Key")
+		if v, ok := d.GetOk("pgp_key"); ok {
+			fingerprint, encrypted, err := encryptValueWithPgpKey(v.(string), *resp.PrivateKeyBase64, "Lightsail Private Key")
 			if err != nil {
 				return err
 			}
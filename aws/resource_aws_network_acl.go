@@ -28,6 +28,8 @@ func resourceAwsNetworkAcl() *schema.Resource {
 			State: resourceAwsNetworkAclImportState,
 		},
 
+		CustomizeDiff: resourceAwsNetworkAclCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -158,6 +160,11 @@ func resourceAwsNetworkAcl() *schema.Resource {
 				Set: resourceAwsNetworkAclEntryHash,
 			},
 			"tags": tagsSchema(),
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -211,6 +218,21 @@ func resourceAwsNetworkAclRead(d *schema.ResourceData, meta interface{}) error {
 	var ingressEntries []*ec2.NetworkAclEntry
 	var egressEntries []*ec2.NetworkAclEntry
 
+	// Unless the entries are managed exclusively, entries which are not
+	// declared inline are left to other resources.
+	exclusive := ruleExclusive(d)
+	d.Set("exclusive", exclusive)
+
+	var declared map[string]bool
+	if !exclusive {
+		declared = make(map[string]bool)
+		for _, entryType := range []string{"ingress", "egress"} {
+			for _, key := range networkAclInlineEntryKeys(entryType, d.Get(entryType).(*schema.Set)) {
+				declared[key] = true
+			}
+		}
+	}
+
 	// separate the ingress and egress rules
 	for _, e := range networkAcl.Entries {
 		// Skip the default rules added by AWS. They can be neither
@@ -220,6 +242,11 @@ func resourceAwsNetworkAclRead(d *schema.ResourceData, meta interface{}) error {
 			continue
 		}
 
+		if declared != nil && !declared[networkAclEntryKey(aws.BoolValue(e.Egress), aws.Int64Value(e.RuleNumber))] {
+			log.Printf("[DEBUG] Ignoring entry not declared in Network ACL (%s): %s", d.Id(), e)
+			continue
+		}
+
 		if *e.Egress == true {
 			egressEntries = append(egressEntries, e)
 		} else {
@@ -249,6 +276,54 @@ func resourceAwsNetworkAclRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceAwsNetworkAclCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("exclusive").(bool) {
+		return nil
+	}
+
+	var removed []string
+	for _, entryType := range []string{"ingress", "egress"} {
+		if !diff.NewValueKnown(entryType) {
+			return nil
+		}
+
+		o, n := diff.GetChange(entryType)
+		newKeys := make(map[string]bool)
+		for _, key := range networkAclInlineEntryKeys(entryType, n.(*schema.Set)) {
+			newKeys[key] = true
+		}
+		for _, key := range networkAclInlineEntryKeys(entryType, o.(*schema.Set)) {
+			if !newKeys[key] {
+				removed = append(removed, key)
+			}
+		}
+	}
+
+	return meta.(*AWSClient).ruleOwnership.inlineRemovals(diff.Id(), removed)
+}
+
+// networkAclInlineEntryKeys returns the keys of the given ingress or egress
+// entry blocks.
+func networkAclInlineEntryKeys(entryType string, entries *schema.Set) []string {
+	keys := make([]string, 0, entries.Len())
+
+	for _, raw := range entries.List() {
+		entry := raw.(map[string]interface{})
+		keys = append(keys, networkAclEntryKey(entryType == "egress", int64(entry["rule_no"].(int))))
+	}
+
+	return keys
+}
+
+// networkAclEntryKey identifies an entry by its direction and rule number,
+// whether it is declared inline or by an aws_network_acl_rule resource.
+func networkAclEntryKey(egress bool, ruleNumber int64) string {
+	if egress {
+		return fmt.Sprintf("egress-%d", ruleNumber)
+	}
+	return fmt.Sprintf("ingress-%d", ruleNumber)
+}
+
 func resourceAwsNetworkAclUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	d.Partial(true)
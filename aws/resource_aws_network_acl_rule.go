@@ -20,6 +20,8 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 		Read:   resourceAwsNetworkAclRuleRead,
 		Delete: resourceAwsNetworkAclRuleDelete,
 
+		CustomizeDiff: resourceAwsNetworkAclRuleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"network_acl_id": {
 				Type:     schema.TypeString,
@@ -91,6 +93,16 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 	}
 }
 
+func resourceAwsNetworkAclRuleCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("network_acl_id") || !diff.NewValueKnown("rule_number") || !diff.NewValueKnown("egress") {
+		return nil
+	}
+
+	key := networkAclEntryKey(diff.Get("egress").(bool), int64(diff.Get("rule_number").(int)))
+
+	return meta.(*AWSClient).ruleOwnership.claimStandalone(diff.Get("network_acl_id").(string), "aws_network_acl_rule", []string{key})
+}
+
 func resourceAwsNetworkAclRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -47,6 +47,8 @@ func resourceAwsRoute() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: resourceAwsRouteCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
@@ -128,6 +130,20 @@ func resourceAwsRoute() *schema.Resource {
 	}
 }
 
+func resourceAwsRouteCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("route_table_id") || !diff.NewValueKnown("destination_cidr_block") || !diff.NewValueKnown("destination_ipv6_cidr_block") {
+		return nil
+	}
+
+	key := routeTableRouteKey(diff.Get("destination_cidr_block").(string), diff.Get("destination_ipv6_cidr_block").(string))
+	if key == "" {
+		// VPC endpoint routes are never declared inline.
+		return nil
+	}
+
+	return meta.(*AWSClient).ruleOwnership.claimStandalone(diff.Get("route_table_id").(string), "aws_route", []string{key})
+}
+
 func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	var numTargets int
@@ -24,6 +24,8 @@ func resourceAwsRouteTable() *schema.Resource {
 			State: resourceAwsRouteTableImportState,
 		},
 
+		CustomizeDiff: resourceAwsRouteTableCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -90,6 +92,12 @@ func resourceAwsRouteTable() *schema.Resource {
 				},
 				Set: resourceAwsRouteTableHash,
 			},
+
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -154,6 +162,19 @@ func resourceAwsRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("propagating_vgws", propagatingVGWs)
 
+	// Unless the routes are managed exclusively, routes to destinations which
+	// are not declared inline are left to other resources.
+	exclusive := ruleExclusive(d)
+	d.Set("exclusive", exclusive)
+
+	var declared map[string]bool
+	if !exclusive {
+		declared = make(map[string]bool)
+		for _, key := range routeTableInlineRouteKeys(d.Get("route").(*schema.Set)) {
+			declared[key] = true
+		}
+	}
+
 	// Create an empty schema.Set to hold all routes
 	route := &schema.Set{F: resourceAwsRouteTableHash}
 
@@ -200,6 +221,11 @@ func resourceAwsRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 			m["network_interface_id"] = *r.NetworkInterfaceId
 		}
 
+		if declared != nil && !declared[routeTableRouteKey(aws.StringValue(r.DestinationCidrBlock), aws.StringValue(r.DestinationIpv6CidrBlock))] {
+			log.Printf("[DEBUG] Ignoring route not declared in Route Table (%s): %s", d.Id(), r)
+			continue
+		}
+
 		route.Add(m)
 	}
 	d.Set("route", route)
@@ -210,6 +236,49 @@ func resourceAwsRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceAwsRouteTableCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("exclusive").(bool) || !diff.NewValueKnown("route") {
+		return nil
+	}
+
+	o, n := diff.GetChange("route")
+	newKeys := make(map[string]bool)
+	for _, key := range routeTableInlineRouteKeys(n.(*schema.Set)) {
+		newKeys[key] = true
+	}
+
+	var removed []string
+	for _, key := range routeTableInlineRouteKeys(o.(*schema.Set)) {
+		if !newKeys[key] {
+			removed = append(removed, key)
+		}
+	}
+
+	return meta.(*AWSClient).ruleOwnership.inlineRemovals(diff.Id(), removed)
+}
+
+// routeTableInlineRouteKeys returns the keys of the destinations of the given
+// route blocks.
+func routeTableInlineRouteKeys(routes *schema.Set) []string {
+	keys := make([]string, 0, routes.Len())
+
+	for _, raw := range routes.List() {
+		route := raw.(map[string]interface{})
+		keys = append(keys, routeTableRouteKey(route["cidr_block"].(string), route["ipv6_cidr_block"].(string)))
+	}
+
+	return keys
+}
+
+// routeTableRouteKey identifies a route by its destination, whether it is
+// declared inline or by an aws_route resource.
+func routeTableRouteKey(cidrBlock, ipv6CidrBlock string) string {
+	if ipv6CidrBlock != "" {
+		return ipv6CidrBlock
+	}
+	return cidrBlock
+}
+
 func resourceAwsRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -267,6 +267,41 @@ func TestAccAWSRouteTable_panicEmptyRoute(t *testing.T) {
 	})
 }
 
+func TestAccAWSRouteTable_nonExclusive(t *testing.T) {
+	var v ec2.RouteTable
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouteTableConfigInlineAndStandaloneRoutes(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists("aws_route_table.test", &v),
+					resource.TestCheckResourceAttr("aws_route_table.test", "exclusive", "false"),
+					resource.TestCheckResourceAttr("aws_route_table.test", "route.#", "1"),
+					resource.TestCheckResourceAttrSet("aws_route.test", "state"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRouteTable_inlineAndStandaloneRoutesConflict(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRouteTableConfigInlineAndStandaloneRoutes(true),
+				ExpectError: regexp.MustCompile(`(would remove rules managed by standalone resources|also managed by the inline rules)`),
+			},
+		},
+	})
+}
+
 func testAccCheckRouteTableDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -644,3 +679,35 @@ resource "aws_route_table" "foo" {
   }
 }
 `
+
+func testAccRouteTableConfigInlineAndStandaloneRoutes(exclusive bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-route-table-inline-and-standalone-routes"
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+}
+
+resource "aws_route_table" "test" {
+  vpc_id    = "${aws_vpc.test.id}"
+  exclusive = %t
+
+  route {
+    cidr_block = "10.2.0.0/16"
+    gateway_id = "${aws_internet_gateway.test.id}"
+  }
+}
+
+resource "aws_route" "test" {
+  route_table_id         = "${aws_route_table.test.id}"
+  destination_cidr_block = "10.3.0.0/16"
+  gateway_id             = "${aws_internet_gateway.test.id}"
+}
+`, exclusive)
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,6 +16,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -32,6 +35,8 @@ func resourceAwsS3Bucket() *schema.Resource {
 			State: resourceAwsS3BucketImportState,
 		},
 
+		CustomizeDiff: resourceAwsS3BucketCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:          schema.TypeString,
@@ -79,34 +84,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"cors_rule": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"allowed_headers": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"allowed_methods": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"allowed_origins": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"expose_headers": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"max_age_seconds": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-					},
-				},
+				Elem:     s3BucketCorsRuleResource(),
 			},
 
 			"website": {
@@ -217,109 +195,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"lifecycle_rule": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringLenBetween(0, 255),
-						},
-						"prefix": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"tags": tagsSchema(),
-						"enabled": {
-							Type:     schema.TypeBool,
-							Required: true,
-						},
-						"abort_incomplete_multipart_upload_days": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"expiration": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Set:      expirationHash,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"date": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validateS3BucketLifecycleTimestamp,
-									},
-									"days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-									"expired_object_delete_marker": {
-										Type:     schema.TypeBool,
-										Optional: true,
-									},
-								},
-							},
-						},
-						"noncurrent_version_expiration": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Set:      expirationHash,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"transition": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Set:      transitionHash,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"date": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validateS3BucketLifecycleTimestamp,
-									},
-									"days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-									"storage_class": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validateS3BucketLifecycleStorageClass(),
-									},
-								},
-							},
-						},
-						"noncurrent_version_transition": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Set:      transitionHash,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-									"storage_class": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validateS3BucketLifecycleStorageClass(),
-									},
-								},
-							},
-						},
-					},
-				},
+				Elem:     s3BucketLifecycleRuleResource(),
 			},
 
 			"force_destroy": {
@@ -362,80 +238,252 @@ func resourceAwsS3Bucket() *schema.Resource {
 							Type:     schema.TypeSet,
 							Required: true,
 							Set:      rulesHash,
+							Elem:     s3BucketReplicationRuleResource(),
+						},
+					},
+				},
+			},
+
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Required: true,
+							Elem:     s3BucketServerSideEncryptionRuleResource(),
+						},
+					},
+				},
+			},
+
+			"externally_managed": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(s3BucketExternallyManagedAttributes, false),
+				},
+				Set: schema.HashString,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+// s3BucketExternallyManagedAttributes are the bucket configurations which can
+// be managed by their own resources, e.g. aws_s3_bucket_cors_configuration,
+// instead of the aws_s3_bucket resource.
+var s3BucketExternallyManagedAttributes = []string{
+	"cors_rule",
+	"lifecycle_rule",
+	"logging",
+	"replication_configuration",
+	"server_side_encryption_configuration",
+	"versioning",
+	"website",
+}
+
+// s3BucketManages returns whether the given configuration of the bucket is
+// managed by the aws_s3_bucket resource rather than by its own resource.
+func s3BucketManages(d interface {
+	Get(string) interface{}
+}, attribute string) bool {
+	return !d.Get("externally_managed").(*schema.Set).Contains(attribute)
+}
+
+func s3BucketCorsRuleResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"allowed_headers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_methods": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_origins": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"expose_headers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_age_seconds": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func s3BucketLifecycleRuleResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tagsSchema(),
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"abort_incomplete_multipart_upload_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"expiration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      expirationHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateS3BucketLifecycleTimestamp,
+						},
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"expired_object_delete_marker": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"noncurrent_version_expiration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      expirationHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"transition": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      transitionHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateS3BucketLifecycleTimestamp,
+						},
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"storage_class": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateS3BucketLifecycleStorageClass(),
+						},
+					},
+				},
+			},
+			"noncurrent_version_transition": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      transitionHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"storage_class": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateS3BucketLifecycleStorageClass(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func s3BucketReplicationRuleResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"destination": {
+				Type:     schema.TypeSet,
+				MaxItems: 1,
+				MinItems: 1,
+				Required: true,
+				Set:      destinationHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3.StorageClassStandard,
+								s3.StorageClassOnezoneIa,
+								s3.StorageClassStandardIa,
+								s3.StorageClassReducedRedundancy,
+							}, false),
+						},
+						"replica_kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"account_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"access_control_translation": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"id": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringLenBetween(0, 255),
-									},
-									"destination": {
-										Type:     schema.TypeSet,
-										MaxItems: 1,
-										MinItems: 1,
-										Required: true,
-										Set:      destinationHash,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"bucket": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validateArn,
-												},
-												"storage_class": {
-													Type:     schema.TypeString,
-													Optional: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														s3.StorageClassStandard,
-														s3.StorageClassOnezoneIa,
-														s3.StorageClassStandardIa,
-														s3.StorageClassReducedRedundancy,
-													}, false),
-												},
-												"replica_kms_key_id": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-											},
-										},
-									},
-									"source_selection_criteria": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										MinItems: 1,
-										MaxItems: 1,
-										Set:      sourceSelectionCriteriaHash,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"sse_kms_encrypted_objects": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													MinItems: 1,
-													MaxItems: 1,
-													Set:      sourceSseKmsObjectsHash,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"enabled": {
-																Type:     schema.TypeBool,
-																Required: true,
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-									"prefix": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringLenBetween(0, 1024),
-									},
-									"status": {
+									"owner": {
 										Type:     schema.TypeString,
 										Required: true,
 										ValidateFunc: validation.StringInSlice([]string{
-											s3.ReplicationRuleStatusEnabled,
-											s3.ReplicationRuleStatusDisabled,
+											s3.OwnerOverrideDestination,
 										}, false),
 									},
 								},
@@ -444,39 +492,25 @@ func resourceAwsS3Bucket() *schema.Resource {
 					},
 				},
 			},
-
-			"server_side_encryption_configuration": {
-				Type:     schema.TypeList,
-				MaxItems: 1,
+			"source_selection_criteria": {
+				Type:     schema.TypeSet,
 				Optional: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Set:      sourceSelectionCriteriaHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"rule": {
-							Type:     schema.TypeList,
+						"sse_kms_encrypted_objects": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
 							MaxItems: 1,
-							Required: true,
+							Set:      sourceSseKmsObjectsHash,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"apply_server_side_encryption_by_default": {
-										Type:     schema.TypeList,
-										MaxItems: 1,
+									"enabled": {
+										Type:     schema.TypeBool,
 										Required: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"kms_master_key_id": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"sse_algorithm": {
-													Type:     schema.TypeString,
-													Required: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														s3.ServerSideEncryptionAes256,
-														s3.ServerSideEncryptionAwsKms,
-													}, false),
-												},
-											},
-										},
 									},
 								},
 							},
@@ -484,8 +518,74 @@ func resourceAwsS3Bucket() *schema.Resource {
 					},
 				},
 			},
+			"prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"tags": tagsSchema(),
+					},
+				},
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"delete_marker_replication_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.DeleteMarkerReplicationStatusEnabled,
+					s3.DeleteMarkerReplicationStatusDisabled,
+				}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ReplicationRuleStatusEnabled,
+					s3.ReplicationRuleStatusDisabled,
+				}, false),
+			},
+		},
+	}
+}
 
-			"tags": tagsSchema(),
+func s3BucketServerSideEncryptionRuleResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"apply_server_side_encryption_by_default": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_master_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sse_algorithm": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3.ServerSideEncryptionAes256,
+								s3.ServerSideEncryptionAwsKms,
+							}, false),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -561,6 +661,8 @@ func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
 	if err := setTagsS3(s3conn, d); err != nil {
 		return fmt.Errorf("%q: %s", d.Get("bucket").(string), err)
 	}
@@ -571,20 +673,24 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("cors_rule") {
-		if err := resourceAwsS3BucketCorsUpdate(s3conn, d); err != nil {
+	if d.HasChange("cors_rule") && s3BucketManages(d, "cors_rule") {
+		if err := resourceAwsS3BucketCorsUpdate(s3conn, bucket, d.Get("cors_rule").([]interface{})); err != nil {
 			return err
 		}
 	}
 
-	if d.HasChange("website") {
-		if err := resourceAwsS3BucketWebsiteUpdate(s3conn, d); err != nil {
+	if d.HasChange("website") && s3BucketManages(d, "website") {
+		if err := resourceAwsS3BucketWebsiteUpdate(s3conn, bucket, d.Get("website").([]interface{})); err != nil {
 			return err
 		}
+		if len(d.Get("website").([]interface{})) == 0 {
+			d.Set("website_endpoint", "")
+			d.Set("website_domain", "")
+		}
 	}
 
-	if d.HasChange("versioning") {
-		if err := resourceAwsS3BucketVersioningUpdate(s3conn, d); err != nil {
+	if d.HasChange("versioning") && s3BucketManages(d, "versioning") {
+		if err := resourceAwsS3BucketVersioningUpdate(s3conn, bucket, d.Get("versioning").([]interface{})); err != nil {
 			return err
 		}
 	}
@@ -594,14 +700,14 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("logging") {
-		if err := resourceAwsS3BucketLoggingUpdate(s3conn, d); err != nil {
+	if d.HasChange("logging") && s3BucketManages(d, "logging") {
+		if err := resourceAwsS3BucketLoggingUpdate(s3conn, bucket, d.Get("logging").(*schema.Set).List()); err != nil {
 			return err
 		}
 	}
 
-	if d.HasChange("lifecycle_rule") {
-		if err := resourceAwsS3BucketLifecycleUpdate(s3conn, d); err != nil {
+	if d.HasChange("lifecycle_rule") && s3BucketManages(d, "lifecycle_rule") {
+		if err := resourceAwsS3BucketLifecycleUpdate(s3conn, bucket, d.Get("lifecycle_rule").([]interface{})); err != nil {
 			return err
 		}
 	}
@@ -618,14 +724,36 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("replication_configuration") {
-		if err := resourceAwsS3BucketReplicationConfigurationUpdate(s3conn, d); err != nil {
+	if d.HasChange("replication_configuration") && s3BucketManages(d, "replication_configuration") {
+		replicationConfiguration := d.Get("replication_configuration").([]interface{})
+
+		if len(replicationConfiguration) > 0 {
+			// Validate that bucket versioning is enabled
+			hasVersioning := false
+			if s3BucketManages(d, "versioning") {
+				if v := d.Get("versioning").([]interface{}); len(v) > 0 && v[0] != nil {
+					hasVersioning = v[0].(map[string]interface{})["enabled"].(bool)
+				}
+			} else {
+				var err error
+				hasVersioning, err = s3BucketVersioningEnabled(s3conn, bucket)
+				if err != nil {
+					return err
+				}
+			}
+
+			if !hasVersioning {
+				return fmt.Errorf("versioning must be enabled to allow S3 bucket replication")
+			}
+		}
+
+		if err := resourceAwsS3BucketReplicationConfigurationUpdate(s3conn, bucket, replicationConfiguration); err != nil {
 			return err
 		}
 	}
 
-	if d.HasChange("server_side_encryption_configuration") {
-		if err := resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn, d); err != nil {
+	if d.HasChange("server_side_encryption_configuration") && s3BucketManages(d, "server_side_encryption_configuration") {
+		if err := resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn, bucket, d.Get("server_side_encryption_configuration").([]interface{})); err != nil {
 			return err
 		}
 	}
@@ -659,6 +787,14 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("bucket_domain_name", bucketDomainName(d.Get("bucket").(string)))
 
+	// Configurations managed by their own resources are not read so that
+	// the bucket does not report differences for them.
+	for _, attribute := range s3BucketExternallyManagedAttributes {
+		if !s3BucketManages(d, attribute) {
+			d.Set(attribute, nil)
+		}
+	}
+
 	// Read the policy
 	if _, ok := d.GetOk("policy"); ok {
 
@@ -688,132 +824,66 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Read the CORS
-	corsResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketCors(&s3.GetBucketCorsInput{
-			Bucket: aws.String(d.Id()),
+	if s3BucketManages(d, "cors_rule") {
+		corsResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return s3conn.GetBucketCors(&s3.GetBucketCorsInput{
+				Bucket: aws.String(d.Id()),
+			})
 		})
-	})
-	if err != nil && !isAWSErr(err, "NoSuchCORSConfiguration", "") {
-		return fmt.Errorf("error getting S3 Bucket CORS configuration: %s", err)
-	}
-
-	corsRules := make([]map[string]interface{}, 0)
-	if cors, ok := corsResponse.(*s3.GetBucketCorsOutput); ok && len(cors.CORSRules) > 0 {
-		corsRules = make([]map[string]interface{}, 0, len(cors.CORSRules))
-		for _, ruleObject := range cors.CORSRules {
-			rule := make(map[string]interface{})
-			rule["allowed_headers"] = flattenStringList(ruleObject.AllowedHeaders)
-			rule["allowed_methods"] = flattenStringList(ruleObject.AllowedMethods)
-			rule["allowed_origins"] = flattenStringList(ruleObject.AllowedOrigins)
-			// Both the "ExposeHeaders" and "MaxAgeSeconds" might not be set.
-			if ruleObject.AllowedOrigins != nil {
-				rule["expose_headers"] = flattenStringList(ruleObject.ExposeHeaders)
-			}
-			if ruleObject.MaxAgeSeconds != nil {
-				rule["max_age_seconds"] = int(*ruleObject.MaxAgeSeconds)
-			}
-			corsRules = append(corsRules, rule)
+		if err != nil && !isAWSErr(err, "NoSuchCORSConfiguration", "") {
+			return fmt.Errorf("error getting S3 Bucket CORS configuration: %s", err)
 		}
-	}
-	if err := d.Set("cors_rule", corsRules); err != nil {
-		return fmt.Errorf("error setting cors_rule: %s", err)
-	}
-
-	// Read the website configuration
-	wsResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
-			Bucket: aws.String(d.Id()),
-		})
-	})
-	if err != nil && !isAWSErr(err, "NotImplemented", "") && !isAWSErr(err, "NoSuchWebsiteConfiguration", "") {
-		return fmt.Errorf("error getting S3 Bucket website configuration: %s", err)
-	}
-
-	websites := make([]map[string]interface{}, 0, 1)
-	if ws, ok := wsResponse.(*s3.GetBucketWebsiteOutput); ok {
-		w := make(map[string]interface{})
 
-		if v := ws.IndexDocument; v != nil {
-			w["index_document"] = *v.Suffix
+		corsRules := make([]map[string]interface{}, 0)
+		if cors, ok := corsResponse.(*s3.GetBucketCorsOutput); ok {
+			corsRules = flattenAwsS3BucketCorsRules(cors.CORSRules)
 		}
-
-		if v := ws.ErrorDocument; v != nil {
-			w["error_document"] = *v.Key
+		if err := d.Set("cors_rule", corsRules); err != nil {
+			return fmt.Errorf("error setting cors_rule: %s", err)
 		}
+	}
 
-		if v := ws.RedirectAllRequestsTo; v != nil {
-			if v.Protocol == nil {
-				w["redirect_all_requests_to"] = *v.HostName
-			} else {
-				var host string
-				var path string
-				var query string
-				parsedHostName, err := url.Parse(*v.HostName)
-				if err == nil {
-					host = parsedHostName.Host
-					path = parsedHostName.Path
-					query = parsedHostName.RawQuery
-				} else {
-					host = *v.HostName
-					path = ""
-				}
-
-				w["redirect_all_requests_to"] = (&url.URL{
-					Host:     host,
-					Path:     path,
-					Scheme:   *v.Protocol,
-					RawQuery: query,
-				}).String()
-			}
+	// Read the website configuration
+	if s3BucketManages(d, "website") {
+		wsResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return s3conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+				Bucket: aws.String(d.Id()),
+			})
+		})
+		if err != nil && !isAWSErr(err, "NotImplemented", "") && !isAWSErr(err, "NoSuchWebsiteConfiguration", "") {
+			return fmt.Errorf("error getting S3 Bucket website configuration: %s", err)
 		}
 
-		if v := ws.RoutingRules; v != nil {
-			rr, err := normalizeRoutingRules(v)
+		websites := make([]map[string]interface{}, 0, 1)
+		if ws, ok := wsResponse.(*s3.GetBucketWebsiteOutput); ok {
+			websites, err = flattenAwsS3BucketWebsite(ws)
 			if err != nil {
-				return fmt.Errorf("Error while marshaling routing rules: %s", err)
+				return err
 			}
-			w["routing_rules"] = rr
 		}
-
-		// We have special handling for the website configuration,
-		// so only add the configuration if there is any
-		if len(w) > 0 {
-			websites = append(websites, w)
+		if err := d.Set("website", websites); err != nil {
+			return fmt.Errorf("error setting website: %s", err)
 		}
 	}
-	if err := d.Set("website", websites); err != nil {
-		return fmt.Errorf("error setting website: %s", err)
-	}
 
 	// Read the versioning configuration
-
-	versioningResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
-			Bucket: aws.String(d.Id()),
+	if s3BucketManages(d, "versioning") {
+		versioningResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return s3conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+				Bucket: aws.String(d.Id()),
+			})
 		})
-	})
-	if err != nil {
-		return err
-	}
-
-	vcl := make([]map[string]interface{}, 0, 1)
-	if versioning, ok := versioningResponse.(*s3.GetBucketVersioningOutput); ok {
-		vc := make(map[string]interface{})
-		if versioning.Status != nil && *versioning.Status == s3.BucketVersioningStatusEnabled {
-			vc["enabled"] = true
-		} else {
-			vc["enabled"] = false
+		if err != nil {
+			return err
 		}
 
-		if versioning.MFADelete != nil && *versioning.MFADelete == s3.MFADeleteEnabled {
-			vc["mfa_delete"] = true
-		} else {
-			vc["mfa_delete"] = false
+		vcl := make([]map[string]interface{}, 0, 1)
+		if versioning, ok := versioningResponse.(*s3.GetBucketVersioningOutput); ok {
+			vcl = flattenAwsS3BucketVersioning(versioning)
+		}
+		if err := d.Set("versioning", vcl); err != nil {
+			return fmt.Errorf("error setting versioning: %s", err)
 		}
-		vcl = append(vcl, vc)
-	}
-	if err := d.Set("versioning", vcl); err != nil {
-		return fmt.Errorf("error setting versioning: %s", err)
 	}
 
 	// Read the acceleration status
@@ -849,192 +919,84 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Read the logging configuration
-	loggingResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketLogging(&s3.GetBucketLoggingInput{
-			Bucket: aws.String(d.Id()),
+	if s3BucketManages(d, "logging") {
+		loggingResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return s3conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+				Bucket: aws.String(d.Id()),
+			})
 		})
-	})
 
-	if err != nil {
-		return fmt.Errorf("error getting S3 Bucket logging: %s", err)
-	}
+		if err != nil {
+			return fmt.Errorf("error getting S3 Bucket logging: %s", err)
+		}
 
-	lcl := make([]map[string]interface{}, 0, 1)
-	if logging, ok := loggingResponse.(*s3.GetBucketLoggingOutput); ok && logging.LoggingEnabled != nil {
-		v := logging.LoggingEnabled
-		lc := make(map[string]interface{})
-		if *v.TargetBucket != "" {
-			lc["target_bucket"] = *v.TargetBucket
+		lcl := make([]map[string]interface{}, 0, 1)
+		if logging, ok := loggingResponse.(*s3.GetBucketLoggingOutput); ok {
+			lcl = flattenAwsS3BucketLogging(logging.LoggingEnabled)
 		}
-		if *v.TargetPrefix != "" {
-			lc["target_prefix"] = *v.TargetPrefix
+		if err := d.Set("logging", lcl); err != nil {
+			return fmt.Errorf("error setting logging: %s", err)
 		}
-		lcl = append(lcl, lc)
-	}
-	if err := d.Set("logging", lcl); err != nil {
-		return fmt.Errorf("error setting logging: %s", err)
 	}
 
 	// Read the lifecycle configuration
-
-	lifecycleResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
-			Bucket: aws.String(d.Id()),
+	if s3BucketManages(d, "lifecycle_rule") {
+		lifecycleResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return s3conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+				Bucket: aws.String(d.Id()),
+			})
 		})
-	})
-	if err != nil && !isAWSErr(err, "NoSuchLifecycleConfiguration", "") {
-		return err
-	}
-
-	lifecycleRules := make([]map[string]interface{}, 0)
-	if lifecycle, ok := lifecycleResponse.(*s3.GetBucketLifecycleConfigurationOutput); ok && len(lifecycle.Rules) > 0 {
-		lifecycleRules = make([]map[string]interface{}, 0, len(lifecycle.Rules))
-
-		for _, lifecycleRule := range lifecycle.Rules {
-			rule := make(map[string]interface{})
-
-			// ID
-			if lifecycleRule.ID != nil && *lifecycleRule.ID != "" {
-				rule["id"] = *lifecycleRule.ID
-			}
-			filter := lifecycleRule.Filter
-			if filter != nil {
-				if filter.And != nil {
-					// Prefix
-					if filter.And.Prefix != nil && *filter.And.Prefix != "" {
-						rule["prefix"] = *filter.And.Prefix
-					}
-					// Tag
-					if len(filter.And.Tags) > 0 {
-						rule["tags"] = tagsToMapS3(filter.And.Tags)
-					}
-				} else {
-					// Prefix
-					if filter.Prefix != nil && *filter.Prefix != "" {
-						rule["prefix"] = *filter.Prefix
-					}
-				}
-			} else {
-				if lifecycleRule.Prefix != nil {
-					rule["prefix"] = *lifecycleRule.Prefix
-				}
-			}
-
-			// Enabled
-			if lifecycleRule.Status != nil {
-				if *lifecycleRule.Status == s3.ExpirationStatusEnabled {
-					rule["enabled"] = true
-				} else {
-					rule["enabled"] = false
-				}
-			}
-
-			// AbortIncompleteMultipartUploadDays
-			if lifecycleRule.AbortIncompleteMultipartUpload != nil {
-				if lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
-					rule["abort_incomplete_multipart_upload_days"] = int(*lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation)
-				}
-			}
-
-			// expiration
-			if lifecycleRule.Expiration != nil {
-				e := make(map[string]interface{})
-				if lifecycleRule.Expiration.Date != nil {
-					e["date"] = (*lifecycleRule.Expiration.Date).Format("2006-01-02")
-				}
-				if lifecycleRule.Expiration.Days != nil {
-					e["days"] = int(*lifecycleRule.Expiration.Days)
-				}
-				if lifecycleRule.Expiration.ExpiredObjectDeleteMarker != nil {
-					e["expired_object_delete_marker"] = *lifecycleRule.Expiration.ExpiredObjectDeleteMarker
-				}
-				rule["expiration"] = schema.NewSet(expirationHash, []interface{}{e})
-			}
-			// noncurrent_version_expiration
-			if lifecycleRule.NoncurrentVersionExpiration != nil {
-				e := make(map[string]interface{})
-				if lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays != nil {
-					e["days"] = int(*lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays)
-				}
-				rule["noncurrent_version_expiration"] = schema.NewSet(expirationHash, []interface{}{e})
-			}
-			//// transition
-			if len(lifecycleRule.Transitions) > 0 {
-				transitions := make([]interface{}, 0, len(lifecycleRule.Transitions))
-				for _, v := range lifecycleRule.Transitions {
-					t := make(map[string]interface{})
-					if v.Date != nil {
-						t["date"] = (*v.Date).Format("2006-01-02")
-					}
-					if v.Days != nil {
-						t["days"] = int(*v.Days)
-					}
-					if v.StorageClass != nil {
-						t["storage_class"] = *v.StorageClass
-					}
-					transitions = append(transitions, t)
-				}
-				rule["transition"] = schema.NewSet(transitionHash, transitions)
-			}
-			// noncurrent_version_transition
-			if len(lifecycleRule.NoncurrentVersionTransitions) > 0 {
-				transitions := make([]interface{}, 0, len(lifecycleRule.NoncurrentVersionTransitions))
-				for _, v := range lifecycleRule.NoncurrentVersionTransitions {
-					t := make(map[string]interface{})
-					if v.NoncurrentDays != nil {
-						t["days"] = int(*v.NoncurrentDays)
-					}
-					if v.StorageClass != nil {
-						t["storage_class"] = *v.StorageClass
-					}
-					transitions = append(transitions, t)
-				}
-				rule["noncurrent_version_transition"] = schema.NewSet(transitionHash, transitions)
-			}
+		if err != nil && !isAWSErr(err, "NoSuchLifecycleConfiguration", "") {
+			return err
+		}
 
-			lifecycleRules = append(lifecycleRules, rule)
+		lifecycleRules := make([]map[string]interface{}, 0)
+		if lifecycle, ok := lifecycleResponse.(*s3.GetBucketLifecycleConfigurationOutput); ok {
+			lifecycleRules = flattenAwsS3BucketLifecycleRules(lifecycle.Rules)
+		}
+		if err := d.Set("lifecycle_rule", lifecycleRules); err != nil {
+			return fmt.Errorf("error setting lifecycle_rule: %s", err)
 		}
-	}
-	if err := d.Set("lifecycle_rule", lifecycleRules); err != nil {
-		return fmt.Errorf("error setting lifecycle_rule: %s", err)
 	}
 
 	// Read the bucket replication configuration
-
-	replicationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
-			Bucket: aws.String(d.Id()),
+	if s3BucketManages(d, "replication_configuration") {
+		replicationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+				Bucket: aws.String(d.Id()),
+			})
 		})
-	})
-	if err != nil && !isAWSErr(err, "ReplicationConfigurationNotFoundError", "") {
-		return fmt.Errorf("error getting S3 Bucket replication: %s", err)
-	}
+		if err != nil && !isAWSErr(err, "ReplicationConfigurationNotFoundError", "") {
+			return fmt.Errorf("error getting S3 Bucket replication: %s", err)
+		}
 
-	replicationConfiguration := make([]map[string]interface{}, 0)
-	if replication, ok := replicationResponse.(*s3.GetBucketReplicationOutput); ok {
-		replicationConfiguration = flattenAwsS3BucketReplicationConfiguration(replication.ReplicationConfiguration)
-	}
-	if err := d.Set("replication_configuration", replicationConfiguration); err != nil {
-		return fmt.Errorf("error setting replication_configuration: %s", err)
+		replicationConfiguration := make([]map[string]interface{}, 0)
+		if replication, ok := replicationResponse.(*s3.GetBucketReplicationOutput); ok {
+			replicationConfiguration = flattenAwsS3BucketReplicationConfiguration(replication.ReplicationConfiguration)
+		}
+		if err := d.Set("replication_configuration", replicationConfiguration); err != nil {
+			return fmt.Errorf("error setting replication_configuration: %s", err)
+		}
 	}
 
 	// Read the bucket server side encryption configuration
-
-	encryptionResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
-			Bucket: aws.String(d.Id()),
+	if s3BucketManages(d, "server_side_encryption_configuration") {
+		encryptionResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return s3conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+				Bucket: aws.String(d.Id()),
+			})
 		})
-	})
-	if err != nil && !isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "encryption configuration was not found") {
-		return fmt.Errorf("error getting S3 Bucket encryption: %s", err)
-	}
+		if err != nil && !isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "encryption configuration was not found") {
+			return fmt.Errorf("error getting S3 Bucket encryption: %s", err)
+		}
 
-	serverSideEncryptionConfiguration := make([]map[string]interface{}, 0)
-	if encryption, ok := encryptionResponse.(*s3.GetBucketEncryptionOutput); ok && encryption.ServerSideEncryptionConfiguration != nil {
-		serverSideEncryptionConfiguration = flattenAwsS3ServerSideEncryptionConfiguration(encryption.ServerSideEncryptionConfiguration)
-	}
-	if err := d.Set("server_side_encryption_configuration", serverSideEncryptionConfiguration); err != nil {
-		return fmt.Errorf("error setting server_side_encryption_configuration: %s", err)
+		serverSideEncryptionConfiguration := make([]map[string]interface{}, 0)
+		if encryption, ok := encryptionResponse.(*s3.GetBucketEncryptionOutput); ok && encryption.ServerSideEncryptionConfiguration != nil {
+			serverSideEncryptionConfiguration = flattenAwsS3ServerSideEncryptionConfiguration(encryption.ServerSideEncryptionConfiguration)
+		}
+		if err := d.Set("server_side_encryption_configuration", serverSideEncryptionConfiguration); err != nil {
+			return fmt.Errorf("error setting server_side_encryption_configuration: %s", err)
+		}
 	}
 
 	// Add the region as an attribute
@@ -1222,12 +1184,9 @@ func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) erro
 	}
 
 	return nil
-}
-
-func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	bucket := d.Get("bucket").(string)
-	rawCors := d.Get("cors_rule").([]interface{})
+}
 
+func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, bucket string, rawCors []interface{}) error {
 	if len(rawCors) == 0 {
 		// Delete CORS
 		log.Printf("[DEBUG] S3 bucket: %s, delete CORS", bucket)
@@ -1290,9 +1249,7 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 	return nil
 }
 
-func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	ws := d.Get("website").([]interface{})
-
+func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, bucket string, ws []interface{}) error {
 	if len(ws) == 1 {
 		var w map[string]interface{}
 		if ws[0] != nil {
@@ -1300,17 +1257,15 @@ func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 		} else {
 			w = make(map[string]interface{})
 		}
-		return resourceAwsS3BucketWebsitePut(s3conn, d, w)
+		return resourceAwsS3BucketWebsitePut(s3conn, bucket, w)
 	} else if len(ws) == 0 {
-		return resourceAwsS3BucketWebsiteDelete(s3conn, d)
+		return resourceAwsS3BucketWebsiteDelete(s3conn, bucket)
 	} else {
 		return fmt.Errorf("Cannot specify more than one website.")
 	}
 }
 
-func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
-	bucket := d.Get("bucket").(string)
-
+func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, bucket string, website map[string]interface{}) error {
 	var indexDocument, errorDocument, redirectAllRequestsTo, routingRules string
 	if v, ok := website["index_document"]; ok {
 		indexDocument = v.(string)
@@ -1382,8 +1337,7 @@ func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, websit
 	return nil
 }
 
-func resourceAwsS3BucketWebsiteDelete(s3conn *s3.S3, d *schema.ResourceData) error {
-	bucket := d.Get("bucket").(string)
+func resourceAwsS3BucketWebsiteDelete(s3conn *s3.S3, bucket string) error {
 	deleteInput := &s3.DeleteBucketWebsiteInput{Bucket: aws.String(bucket)}
 
 	log.Printf("[DEBUG] S3 delete bucket website: %#v", deleteInput)
@@ -1395,9 +1349,6 @@ func resourceAwsS3BucketWebsiteDelete(s3conn *s3.S3, d *schema.ResourceData) err
 		return fmt.Errorf("Error deleting S3 website: %s", err)
 	}
 
-	d.Set("website_endpoint", "")
-	d.Set("website_domain", "")
-
 	return nil
 }
 
@@ -1505,9 +1456,7 @@ func resourceAwsS3BucketAclUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	v := d.Get("versioning").([]interface{})
-	bucket := d.Get("bucket").(string)
+func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, bucket string, v []interface{}) error {
 	vc := &s3.VersioningConfiguration{}
 
 	if len(v) > 0 {
@@ -1545,9 +1494,180 @@ func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData)
 	return nil
 }
 
-func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	logging := d.Get("logging").(*schema.Set).List()
-	bucket := d.Get("bucket").(string)
+// s3BucketVersioningEnabled returns whether versioning is enabled on the bucket.
+func s3BucketVersioningEnabled(s3conn *s3.S3, bucket string) (bool, error) {
+	resp, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	if err != nil {
+		return false, fmt.Errorf("error getting S3 Bucket (%s) versioning: %s", bucket, err)
+	}
+
+	return aws.StringValue(resp.(*s3.GetBucketVersioningOutput).Status) == s3.BucketVersioningStatusEnabled, nil
+}
+
+// validateS3BucketReplicationRules checks the replication rules for settings
+// that S3 would only reject once the configuration is put.
+func validateS3BucketReplicationRules(rules []interface{}) error {
+	var errs *multierror.Error
+
+	for _, v := range rules {
+		rule := v.(map[string]interface{})
+		id := rule["id"].(string)
+
+		var destination map[string]interface{}
+		if v, ok := rule["destination"].(*schema.Set); ok && v.Len() > 0 {
+			destination = v.List()[0].(map[string]interface{})
+		}
+
+		if v, ok := rule["filter"].([]interface{}); !ok || len(v) == 0 {
+			if rule["priority"].(int) != 0 {
+				errs = multierror.Append(errs, fmt.Errorf("replication rule %q: priority can only be set with filter", id))
+			}
+			if rule["delete_marker_replication_status"].(string) != "" {
+				errs = multierror.Append(errs, fmt.Errorf("replication rule %q: delete_marker_replication_status can only be set with filter", id))
+			}
+		} else if rule["prefix"].(string) != "" {
+			errs = multierror.Append(errs, fmt.Errorf("replication rule %q: prefix conflicts with filter, use filter.prefix instead", id))
+		}
+
+		if v, ok := rule["source_selection_criteria"].(*schema.Set); ok && v.Len() > 0 && v.List()[0] != nil {
+			criteria := v.List()[0].(map[string]interface{})
+			if v, ok := criteria["sse_kms_encrypted_objects"].(*schema.Set); ok && v.Len() > 0 && v.List()[0].(map[string]interface{})["enabled"].(bool) {
+				if destination == nil || destination["replica_kms_key_id"].(string) == "" {
+					errs = multierror.Append(errs, fmt.Errorf("replication rule %q: destination replica_kms_key_id must be set to replicate SSE-KMS encrypted objects", id))
+				}
+			}
+		}
+
+		if destination != nil {
+			if v, ok := destination["access_control_translation"].([]interface{}); ok && len(v) > 0 && destination["account_id"].(string) == "" {
+				errs = multierror.Append(errs, fmt.Errorf("replication rule %q: destination account_id must be set with access_control_translation", id))
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// s3BucketReplicationCheckVersioning returns an error if the bucket exists
+// without versioning enabled. Buckets which don't exist or can't be read
+// with the current credentials are not checked.
+func s3BucketReplicationCheckVersioning(s3conn *s3.S3, bucket string) error {
+	region, err := s3manager.GetBucketRegionWithClient(aws.BackgroundContext(), s3conn, bucket)
+	if err != nil {
+		log.Printf("[WARN] Unable to determine S3 Bucket (%s) region, skipping versioning check: %s", bucket, err)
+		return nil
+	}
+
+	resp, err := s3ConnForRegion(s3conn, region).GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucket),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "AccessDenied", "") {
+		log.Printf("[WARN] Unable to read S3 Bucket (%s) versioning, skipping versioning check: %s", bucket, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) versioning: %s", bucket, err)
+	}
+
+	if aws.StringValue(resp.Status) != s3.BucketVersioningStatusEnabled {
+		return fmt.Errorf("versioning must be enabled on S3 Bucket (%s) to allow replication", bucket)
+	}
+
+	return nil
+}
+
+// s3BucketReplicationCheckDestinations checks versioning on the destination
+// buckets of the replication rules. It runs on apply rather than on plan, as
+// the versioning of a destination bucket may be enabled in the same apply by
+// a resource of another provider configuration, which has been applied by
+// the time the replication configuration depending on it is.
+func s3BucketReplicationCheckDestinations(s3conn *s3.S3, rules []interface{}) error {
+	for _, v := range rules {
+		destination, ok := v.(map[string]interface{})["destination"].(*schema.Set)
+		if !ok || destination.Len() == 0 {
+			continue
+		}
+
+		bucketArn, err := arn.Parse(destination.List()[0].(map[string]interface{})["bucket"].(string))
+		if err != nil {
+			continue
+		}
+
+		if err := s3BucketReplicationCheckVersioning(s3conn, bucketArn.Resource); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if err := s3BucketCheckExternallyManaged(diff); err != nil {
+		return err
+	}
+
+	if !s3BucketManages(diff, "replication_configuration") {
+		return nil
+	}
+
+	if !diff.HasChange("replication_configuration") && !diff.HasChange("versioning") {
+		return nil
+	}
+
+	replicationConfiguration := diff.Get("replication_configuration").([]interface{})
+	if len(replicationConfiguration) == 0 || replicationConfiguration[0] == nil {
+		return nil
+	}
+	rules := replicationConfiguration[0].(map[string]interface{})["rules"].(*schema.Set).List()
+
+	if err := validateS3BucketReplicationRules(rules); err != nil {
+		return err
+	}
+
+	// Only the versioning configured on the bucket itself is checked when
+	// planning. Versioning changed by other resources in the same plan isn't
+	// known yet, so the remaining buckets are checked on apply.
+	if s3BucketManages(diff, "versioning") {
+		hasVersioning := false
+		if v := diff.Get("versioning").([]interface{}); len(v) > 0 && v[0] != nil {
+			hasVersioning = v[0].(map[string]interface{})["enabled"].(bool)
+		}
+		if !hasVersioning {
+			return fmt.Errorf("versioning must be enabled to allow S3 bucket replication")
+		}
+	}
+
+	return nil
+}
+
+// s3BucketCheckExternallyManaged rejects configurations which are both
+// externally managed and configured on the bucket, as they would never
+// converge.
+func s3BucketCheckExternallyManaged(diff *schema.ResourceDiff) error {
+	o, n := diff.GetChange("externally_managed")
+	for _, v := range n.(*schema.Set).List() {
+		attribute := v.(string)
+
+		// Versioning is computed, so its state is only cleared by the refresh
+		// after it became externally managed. Until then the value may not
+		// come from the configuration.
+		if attribute == "versioning" && diff.Id() != "" && !o.(*schema.Set).Contains(attribute) {
+			continue
+		}
+
+		if _, ok := diff.GetOk(attribute); ok {
+			return fmt.Errorf("%q is externally managed and cannot be configured on aws_s3_bucket", attribute)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, bucket string, logging []interface{}) error {
 	loggingStatus := &s3.BucketLoggingStatus{}
 
 	if len(logging) > 0 {
@@ -1624,9 +1744,7 @@ func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData
 	return nil
 }
 
-func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	bucket := d.Get("bucket").(string)
-	serverSideEncryptionConfiguration := d.Get("server_side_encryption_configuration").([]interface{})
+func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, bucket string, serverSideEncryptionConfiguration []interface{}) error {
 	if len(serverSideEncryptionConfiguration) == 0 {
 		log.Printf("[DEBUG] Delete server side encryption configuration: %#v", serverSideEncryptionConfiguration)
 		i := &s3.DeleteBucketEncryptionInput{
@@ -1686,10 +1804,7 @@ func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d
 	return nil
 }
 
-func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	bucket := d.Get("bucket").(string)
-	replicationConfiguration := d.Get("replication_configuration").([]interface{})
-
+func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, bucket string, replicationConfiguration []interface{}) error {
 	if len(replicationConfiguration) == 0 {
 		i := &s3.DeleteBucketReplicationInput{
 			Bucket: aws.String(bucket),
@@ -1707,20 +1822,6 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 		return nil
 	}
 
-	hasVersioning := false
-	// Validate that bucket versioning is enabled
-	if versioning, ok := d.GetOk("versioning"); ok {
-		v := versioning.([]interface{})
-
-		if v[0].(map[string]interface{})["enabled"].(bool) {
-			hasVersioning = true
-		}
-	}
-
-	if !hasVersioning {
-		return fmt.Errorf("versioning must be enabled to allow S3 bucket replication")
-	}
-
 	c := replicationConfiguration[0].(map[string]interface{})
 
 	rc := &s3.ReplicationConfiguration{}
@@ -1729,11 +1830,14 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 	}
 
 	rcRules := c["rules"].(*schema.Set).List()
+	if err := s3BucketReplicationCheckDestinations(s3conn, rcRules); err != nil {
+		return err
+	}
+
 	rules := []*s3.ReplicationRule{}
 	for _, v := range rcRules {
 		rr := v.(map[string]interface{})
 		rcRule := &s3.ReplicationRule{
-			Prefix: aws.String(rr["prefix"].(string)),
 			Status: aws.String(rr["status"].(string)),
 		}
 
@@ -1741,6 +1845,27 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 			rcRule.ID = aws.String(rrid.(string))
 		}
 
+		// Rules with a filter use the V2 replication configuration schema,
+		// which also requires a priority and a delete marker replication status.
+		if f, ok := rr["filter"].([]interface{}); ok && len(f) > 0 {
+			var filter map[string]interface{}
+			if f[0] != nil {
+				filter = f[0].(map[string]interface{})
+			}
+			rcRule.Filter = expandS3ReplicationRuleFilter(filter)
+			rcRule.Priority = aws.Int64(int64(rr["priority"].(int)))
+
+			deleteMarkerReplicationStatus := s3.DeleteMarkerReplicationStatusDisabled
+			if v, ok := rr["delete_marker_replication_status"]; ok && v.(string) != "" {
+				deleteMarkerReplicationStatus = v.(string)
+			}
+			rcRule.DeleteMarkerReplication = &s3.DeleteMarkerReplication{
+				Status: aws.String(deleteMarkerReplicationStatus),
+			}
+		} else {
+			rcRule.Prefix = aws.String(rr["prefix"].(string))
+		}
+
 		ruleDestination := &s3.Destination{}
 		if dest, ok := rr["destination"].(*schema.Set); ok && dest.Len() > 0 {
 			bd := dest.List()[0].(map[string]interface{})
@@ -1755,6 +1880,16 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 					ReplicaKmsKeyID: aws.String(replicaKmsKeyId.(string)),
 				}
 			}
+
+			if account, ok := bd["account_id"]; ok && account != "" {
+				ruleDestination.Account = aws.String(account.(string))
+			}
+
+			if act, ok := bd["access_control_translation"].([]interface{}); ok && len(act) > 0 && act[0] != nil {
+				ruleDestination.AccessControlTranslation = &s3.AccessControlTranslation{
+					Owner: aws.String(act[0].(map[string]interface{})["owner"].(string)),
+				}
+			}
 		}
 		rcRule.Destination = ruleDestination
 
@@ -1793,11 +1928,36 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 	return nil
 }
 
-func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	bucket := d.Get("bucket").(string)
+func expandS3ReplicationRuleFilter(m map[string]interface{}) *s3.ReplicationRuleFilter {
+	var prefix string
+	if v, ok := m["prefix"]; ok {
+		prefix = v.(string)
+	}
+
+	var tags []*s3.Tag
+	if v, ok := m["tags"]; ok {
+		tags = tagsFromMapS3(v.(map[string]interface{}))
+	}
 
-	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
+	replicationRuleFilter := &s3.ReplicationRuleFilter{}
+	if prefix != "" && len(tags) > 0 {
+		replicationRuleFilter.And = &s3.ReplicationRuleAndOperator{
+			Prefix: aws.String(prefix),
+			Tags:   tags,
+		}
+	} else if len(tags) > 1 {
+		replicationRuleFilter.And = &s3.ReplicationRuleAndOperator{
+			Tags: tags,
+		}
+	} else if len(tags) == 1 {
+		replicationRuleFilter.Tag = tags[0]
+	} else {
+		replicationRuleFilter.Prefix = aws.String(prefix)
+	}
+	return replicationRuleFilter
+}
 
+func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, bucket string, lifecycleRules []interface{}) error {
 	if len(lifecycleRules) == 0 {
 		i := &s3.DeleteBucketLifecycleInput{
 			Bucket: aws.String(bucket),
@@ -1817,7 +1977,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 
 	rules := make([]*s3.LifecycleRule, 0, len(lifecycleRules))
 
-	for i, lifecycleRule := range lifecycleRules {
+	for _, lifecycleRule := range lifecycleRules {
 		r := lifecycleRule.(map[string]interface{})
 
 		rule := &s3.LifecycleRule{}
@@ -1857,7 +2017,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		}
 
 		// Expiration
-		expiration := d.Get(fmt.Sprintf("lifecycle_rule.%d.expiration", i)).(*schema.Set).List()
+		expiration := r["expiration"].(*schema.Set).List()
 		if len(expiration) > 0 {
 			e := expiration[0].(map[string]interface{})
 			i := &s3.LifecycleExpiration{}
@@ -1877,7 +2037,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		}
 
 		// NoncurrentVersionExpiration
-		nc_expiration := d.Get(fmt.Sprintf("lifecycle_rule.%d.noncurrent_version_expiration", i)).(*schema.Set).List()
+		nc_expiration := r["noncurrent_version_expiration"].(*schema.Set).List()
 		if len(nc_expiration) > 0 {
 			e := nc_expiration[0].(map[string]interface{})
 
@@ -1889,7 +2049,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		}
 
 		// Transitions
-		transitions := d.Get(fmt.Sprintf("lifecycle_rule.%d.transition", i)).(*schema.Set).List()
+		transitions := r["transition"].(*schema.Set).List()
 		if len(transitions) > 0 {
 			rule.Transitions = make([]*s3.Transition, 0, len(transitions))
 			for _, transition := range transitions {
@@ -1912,7 +2072,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 			}
 		}
 		// NoncurrentVersionTransitions
-		nc_transitions := d.Get(fmt.Sprintf("lifecycle_rule.%d.noncurrent_version_transition", i)).(*schema.Set).List()
+		nc_transitions := r["noncurrent_version_transition"].(*schema.Set).List()
 		if len(nc_transitions) > 0 {
 			rule.NoncurrentVersionTransitions = make([]*s3.NoncurrentVersionTransition, 0, len(nc_transitions))
 			for _, transition := range nc_transitions {
@@ -1952,6 +2112,229 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 	return nil
 }
 
+func flattenAwsS3BucketCorsRules(rules []*s3.CORSRule) []map[string]interface{} {
+	corsRules := make([]map[string]interface{}, 0, len(rules))
+
+	for _, ruleObject := range rules {
+		rule := make(map[string]interface{})
+		rule["allowed_headers"] = flattenStringList(ruleObject.AllowedHeaders)
+		rule["allowed_methods"] = flattenStringList(ruleObject.AllowedMethods)
+		rule["allowed_origins"] = flattenStringList(ruleObject.AllowedOrigins)
+		// Both the "ExposeHeaders" and "MaxAgeSeconds" might not be set.
+		if ruleObject.AllowedOrigins != nil {
+			rule["expose_headers"] = flattenStringList(ruleObject.ExposeHeaders)
+		}
+		if ruleObject.MaxAgeSeconds != nil {
+			rule["max_age_seconds"] = int(*ruleObject.MaxAgeSeconds)
+		}
+		corsRules = append(corsRules, rule)
+	}
+
+	return corsRules
+}
+
+func flattenAwsS3BucketWebsite(ws *s3.GetBucketWebsiteOutput) ([]map[string]interface{}, error) {
+	websites := make([]map[string]interface{}, 0, 1)
+	w := make(map[string]interface{})
+
+	if v := ws.IndexDocument; v != nil {
+		w["index_document"] = *v.Suffix
+	}
+
+	if v := ws.ErrorDocument; v != nil {
+		w["error_document"] = *v.Key
+	}
+
+	if v := ws.RedirectAllRequestsTo; v != nil {
+		if v.Protocol == nil {
+			w["redirect_all_requests_to"] = *v.HostName
+		} else {
+			var host string
+			var path string
+			var query string
+			parsedHostName, err := url.Parse(*v.HostName)
+			if err == nil {
+				host = parsedHostName.Host
+				path = parsedHostName.Path
+				query = parsedHostName.RawQuery
+			} else {
+				host = *v.HostName
+				path = ""
+			}
+
+			w["redirect_all_requests_to"] = (&url.URL{
+				Host:     host,
+				Path:     path,
+				Scheme:   *v.Protocol,
+				RawQuery: query,
+			}).String()
+		}
+	}
+
+	if v := ws.RoutingRules; v != nil {
+		rr, err := normalizeRoutingRules(v)
+		if err != nil {
+			return nil, fmt.Errorf("Error while marshaling routing rules: %s", err)
+		}
+		w["routing_rules"] = rr
+	}
+
+	// We have special handling for the website configuration,
+	// so only add the configuration if there is any
+	if len(w) > 0 {
+		websites = append(websites, w)
+	}
+
+	return websites, nil
+}
+
+func flattenAwsS3BucketVersioning(versioning *s3.GetBucketVersioningOutput) []map[string]interface{} {
+	vc := make(map[string]interface{})
+
+	if versioning.Status != nil && *versioning.Status == s3.BucketVersioningStatusEnabled {
+		vc["enabled"] = true
+	} else {
+		vc["enabled"] = false
+	}
+
+	if versioning.MFADelete != nil && *versioning.MFADelete == s3.MFADeleteEnabled {
+		vc["mfa_delete"] = true
+	} else {
+		vc["mfa_delete"] = false
+	}
+
+	return []map[string]interface{}{vc}
+}
+
+func flattenAwsS3BucketLogging(v *s3.LoggingEnabled) []map[string]interface{} {
+	lcl := make([]map[string]interface{}, 0, 1)
+
+	if v == nil {
+		return lcl
+	}
+
+	lc := make(map[string]interface{})
+	if *v.TargetBucket != "" {
+		lc["target_bucket"] = *v.TargetBucket
+	}
+	if *v.TargetPrefix != "" {
+		lc["target_prefix"] = *v.TargetPrefix
+	}
+
+	return append(lcl, lc)
+}
+
+func flattenAwsS3BucketLifecycleRules(rules []*s3.LifecycleRule) []map[string]interface{} {
+	lifecycleRules := make([]map[string]interface{}, 0, len(rules))
+
+	for _, lifecycleRule := range rules {
+		rule := make(map[string]interface{})
+
+		// ID
+		if lifecycleRule.ID != nil && *lifecycleRule.ID != "" {
+			rule["id"] = *lifecycleRule.ID
+		}
+		filter := lifecycleRule.Filter
+		if filter != nil {
+			if filter.And != nil {
+				// Prefix
+				if filter.And.Prefix != nil && *filter.And.Prefix != "" {
+					rule["prefix"] = *filter.And.Prefix
+				}
+				// Tag
+				if len(filter.And.Tags) > 0 {
+					rule["tags"] = tagsToMapS3(filter.And.Tags)
+				}
+			} else {
+				// Prefix
+				if filter.Prefix != nil && *filter.Prefix != "" {
+					rule["prefix"] = *filter.Prefix
+				}
+			}
+		} else {
+			if lifecycleRule.Prefix != nil {
+				rule["prefix"] = *lifecycleRule.Prefix
+			}
+		}
+
+		// Enabled
+		if lifecycleRule.Status != nil {
+			if *lifecycleRule.Status == s3.ExpirationStatusEnabled {
+				rule["enabled"] = true
+			} else {
+				rule["enabled"] = false
+			}
+		}
+
+		// AbortIncompleteMultipartUploadDays
+		if lifecycleRule.AbortIncompleteMultipartUpload != nil {
+			if lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
+				rule["abort_incomplete_multipart_upload_days"] = int(*lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation)
+			}
+		}
+
+		// expiration
+		if lifecycleRule.Expiration != nil {
+			e := make(map[string]interface{})
+			if lifecycleRule.Expiration.Date != nil {
+				e["date"] = (*lifecycleRule.Expiration.Date).Format("2006-01-02")
+			}
+			if lifecycleRule.Expiration.Days != nil {
+				e["days"] = int(*lifecycleRule.Expiration.Days)
+			}
+			if lifecycleRule.Expiration.ExpiredObjectDeleteMarker != nil {
+				e["expired_object_delete_marker"] = *lifecycleRule.Expiration.ExpiredObjectDeleteMarker
+			}
+			rule["expiration"] = schema.NewSet(expirationHash, []interface{}{e})
+		}
+		// noncurrent_version_expiration
+		if lifecycleRule.NoncurrentVersionExpiration != nil {
+			e := make(map[string]interface{})
+			if lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays != nil {
+				e["days"] = int(*lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays)
+			}
+			rule["noncurrent_version_expiration"] = schema.NewSet(expirationHash, []interface{}{e})
+		}
+		//// transition
+		if len(lifecycleRule.Transitions) > 0 {
+			transitions := make([]interface{}, 0, len(lifecycleRule.Transitions))
+			for _, v := range lifecycleRule.Transitions {
+				t := make(map[string]interface{})
+				if v.Date != nil {
+					t["date"] = (*v.Date).Format("2006-01-02")
+				}
+				if v.Days != nil {
+					t["days"] = int(*v.Days)
+				}
+				if v.StorageClass != nil {
+					t["storage_class"] = *v.StorageClass
+				}
+				transitions = append(transitions, t)
+			}
+			rule["transition"] = schema.NewSet(transitionHash, transitions)
+		}
+		// noncurrent_version_transition
+		if len(lifecycleRule.NoncurrentVersionTransitions) > 0 {
+			transitions := make([]interface{}, 0, len(lifecycleRule.NoncurrentVersionTransitions))
+			for _, v := range lifecycleRule.NoncurrentVersionTransitions {
+				t := make(map[string]interface{})
+				if v.NoncurrentDays != nil {
+					t["days"] = int(*v.NoncurrentDays)
+				}
+				if v.StorageClass != nil {
+					t["storage_class"] = *v.StorageClass
+				}
+				transitions = append(transitions, t)
+			}
+			rule["noncurrent_version_transition"] = schema.NewSet(transitionHash, transitions)
+		}
+
+		lifecycleRules = append(lifecycleRules, rule)
+	}
+
+	return lifecycleRules
+}
+
 func flattenAwsS3ServerSideEncryptionConfiguration(c *s3.ServerSideEncryptionConfiguration) []map[string]interface{} {
 	var encryptionConfiguration []map[string]interface{}
 	rules := make([]interface{}, 0, len(c.Rules))
@@ -2000,6 +2383,16 @@ func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration)
 					rd["replica_kms_key_id"] = *v.Destination.EncryptionConfiguration.ReplicaKmsKeyID
 				}
 			}
+			if v.Destination.Account != nil {
+				rd["account_id"] = *v.Destination.Account
+			}
+			if v.Destination.AccessControlTranslation != nil {
+				rd["access_control_translation"] = []interface{}{
+					map[string]interface{}{
+						"owner": aws.StringValue(v.Destination.AccessControlTranslation.Owner),
+					},
+				}
+			}
 			t["destination"] = schema.NewSet(destinationHash, []interface{}{rd})
 		}
 
@@ -2009,6 +2402,15 @@ func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration)
 		if v.Prefix != nil {
 			t["prefix"] = *v.Prefix
 		}
+		if v.Filter != nil {
+			t["filter"] = flattenS3ReplicationRuleFilter(v.Filter)
+		}
+		if v.Priority != nil {
+			t["priority"] = int(*v.Priority)
+		}
+		if v.DeleteMarkerReplication != nil && v.DeleteMarkerReplication.Status != nil {
+			t["delete_marker_replication_status"] = *v.DeleteMarkerReplication.Status
+		}
 		if v.Status != nil {
 			t["status"] = *v.Status
 		}
@@ -2034,6 +2436,36 @@ func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration)
 	return replication_configuration
 }
 
+func flattenS3ReplicationRuleFilter(filter *s3.ReplicationRuleFilter) []interface{} {
+	m := make(map[string]interface{})
+
+	if filter.And != nil {
+		if filter.And.Prefix != nil {
+			m["prefix"] = aws.StringValue(filter.And.Prefix)
+		}
+		if filter.And.Tags != nil {
+			m["tags"] = flattenS3ReplicationRuleFilterTags(filter.And.Tags)
+		}
+	} else if filter.Tag != nil {
+		m["tags"] = flattenS3ReplicationRuleFilterTags([]*s3.Tag{filter.Tag})
+	} else if filter.Prefix != nil {
+		m["prefix"] = aws.StringValue(filter.Prefix)
+	}
+
+	return []interface{}{m}
+}
+
+// flattenS3ReplicationRuleFilterTags returns the tags in the form expected by
+// replicationRuleFilterHash and expandS3ReplicationRuleFilter.
+func flattenS3ReplicationRuleFilterTags(tags []*s3.Tag) map[string]interface{} {
+	m := make(map[string]interface{}, len(tags))
+	for k, v := range tagsToMapS3(tags) {
+		m[k] = v
+	}
+
+	return m
+}
+
 func normalizeRoutingRules(w []*s3.RoutingRule) (string, error) {
 	withNulls, err := json.Marshal(w)
 	if err != nil {
@@ -2170,6 +2602,42 @@ func rulesHash(v interface{}) int {
 	if v, ok := m["source_selection_criteria"].(*schema.Set); ok && v.Len() > 0 && v.List()[0] != nil {
 		buf.WriteString(fmt.Sprintf("%d-", sourceSelectionCriteriaHash(v.List()[0])))
 	}
+	if v, ok := m["filter"].([]interface{}); ok && len(v) > 0 {
+		buf.WriteString(fmt.Sprintf("%d-", replicationRuleFilterHash(v[0])))
+
+		if v, ok := m["priority"]; ok {
+			buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+		}
+		// An unset delete marker replication status is sent as Disabled.
+		if v, ok := m["delete_marker_replication_status"]; ok && v.(string) == s3.DeleteMarkerReplicationStatusEnabled {
+			buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+		}
+	}
+	return hashcode.String(buf.String())
+}
+
+func replicationRuleFilterHash(v interface{}) int {
+	// v is nil if an empty filter is given.
+	if v == nil {
+		return 0
+	}
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if v, ok := m["prefix"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["tags"]; ok {
+		tags := v.(map[string]interface{})
+		keys := make([]string, 0, len(tags))
+		for k := range tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			buf.WriteString(fmt.Sprintf("%s=%s-", k, tags[k].(string)))
+		}
+	}
 	return hashcode.String(buf.String())
 }
 
@@ -2186,6 +2654,12 @@ func destinationHash(v interface{}) int {
 	if v, ok := m["replica_kms_key_id"]; ok {
 		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
 	}
+	if v, ok := m["account_id"]; ok && v.(string) != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["access_control_translation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		buf.WriteString(fmt.Sprintf("%s-", v[0].(map[string]interface{})["owner"].(string)))
+	}
 	return hashcode.String(buf.String())
 }
 
@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3BucketLogging_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_logging.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketLoggingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rInt, "log/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("aws_s3_bucket.test"),
+					testAccCheckAWSS3BucketLogging("aws_s3_bucket.test", "aws_s3_bucket.log_bucket", "log/"),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "log/"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.test", "logging.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rInt, "access/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLogging("aws_s3_bucket.test", "aws_s3_bucket.log_bucket", "access/"),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "access/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketLoggingDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_logging" {
+			continue
+		}
+
+		resp, err := conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if resp.LoggingEnabled != nil {
+			return fmt.Errorf("S3 Bucket logging (%s) still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSS3BucketLoggingConfig(randInt int, prefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "log_bucket" {
+  bucket = "tf-test-log-bucket-%d"
+  acl    = "log-delivery-write"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket             = "tf-test-bucket-%d"
+  externally_managed = ["logging"]
+}
+
+resource "aws_s3_bucket_logging" "test" {
+  bucket        = "${aws_s3_bucket.test.id}"
+  target_bucket = "${aws_s3_bucket.log_bucket.id}"
+  target_prefix = "%s"
+}
+`, randInt, randInt, prefix)
+}
@@ -2,12 +2,15 @@ package aws
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
 	"net/url"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,15 +21,22 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 )
 
+// s3ObjectMaxSinglePutSize is the largest object which can be uploaded in a
+// single PUT request.
+const s3ObjectMaxSinglePutSize = 5 * 1024 * 1024 * 1024
+
 func resourceAwsS3BucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsS3BucketObjectPut,
 		Read:   resourceAwsS3BucketObjectRead,
-		Update: resourceAwsS3BucketObjectPut,
+		Update: resourceAwsS3BucketObjectUpdate,
 		Delete: resourceAwsS3BucketObjectDelete,
 
+		CustomizeDiff: resourceAwsS3BucketObjectCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -35,9 +45,10 @@ func resourceAwsS3BucketObject() *schema.Resource {
 			},
 
 			"acl": {
-				Type:     schema.TypeString,
-				Default:  "private",
-				Optional: true,
+				Type:          schema.TypeString,
+				Default:       "private",
+				Optional:      true,
+				ConflictsWith: []string{"grant"},
 				ValidateFunc: validation.StringInSlice([]string{
 					s3.ObjectCannedACLPrivate,
 					s3.ObjectCannedACLPublicRead,
@@ -49,6 +60,45 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				}, false),
 			},
 
+			"grant": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"acl"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3.TypeCanonicalUser,
+								s3.TypeGroup,
+							}, false),
+						},
+						"uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"permissions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									s3.PermissionFullControl,
+									s3.PermissionRead,
+									s3.PermissionReadAcp,
+									s3.PermissionWriteAcp,
+								}, false),
+							},
+						},
+					},
+				},
+			},
+
 			"cache_control": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -75,6 +125,13 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Computed: true,
 			},
 
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateS3BucketObjectMetadata,
+			},
+
 			"key": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -87,6 +144,11 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				ConflictsWith: []string{"content", "content_base64"},
 			},
 
+			"source_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -129,8 +191,8 @@ func resourceAwsS3BucketObject() *schema.Resource {
 
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This conflicts with SSE-C and SSE-KMS encryption and multi-part upload,
+				// where the Etag won't match raw-file MD5. Use source_hash to track content instead.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
@@ -148,27 +210,95 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
+
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3manager.DefaultUploadConcurrency,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
 		},
 	}
 }
 
+func resourceAwsS3BucketObjectCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("source") {
+		return diff.SetNewComputed("source_hash")
+	}
+
+	source := diff.Get("source").(string)
+	if source == "" {
+		if diff.Get("source_hash").(string) != "" {
+			return diff.SetNew("source_hash", "")
+		}
+		return nil
+	}
+
+	hash, err := s3ObjectSourceHash(source)
+	if err != nil {
+		return err
+	}
+
+	if hash != diff.Get("source_hash").(string) {
+		return diff.SetNew("source_hash", hash)
+	}
+
+	return nil
+}
+
+// s3ObjectSourceHash returns the hex encoded SHA-256 digest of the file at source.
+func s3ObjectSourceHash(source string) (string, error) {
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return "", fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("Error opening S3 bucket object source (%s): %s", source, err)
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", fmt.Errorf("Error reading S3 bucket object source (%s): %s", source, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
 	restricted := meta.(*AWSClient).IsChinaCloud()
 
 	var body io.ReadSeeker
+	var sourceHash string
 
 	if v, ok := d.GetOk("source"); ok {
 		source := v.(string)
+		hash, err := s3ObjectSourceHash(source)
+		if err != nil {
+			return err
+		}
+		sourceHash = hash
+
 		path, err := homedir.Expand(source)
 		if err != nil {
 			return fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
 		}
+		// The uploader reads the file in parts rather than buffering it in memory.
 		file, err := os.Open(path)
 		if err != nil {
 			return fmt.Errorf("Error opening S3 bucket object source (%s): %s", source, err)
 		}
+		defer file.Close()
 
 		body = file
 	} else if v, ok := d.GetOk("content"); ok {
@@ -190,13 +320,26 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	putInput := &s3.PutObjectInput{
+	putInput := &s3manager.UploadInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
-		ACL:    aws.String(d.Get("acl").(string)),
 		Body:   body,
 	}
 
+	if v, ok := d.GetOk("grant"); ok && v.(*schema.Set).Len() > 0 {
+		grants := expandS3BucketObjectGrantHeaders(v.(*schema.Set).List())
+		putInput.GrantFullControl = grants[s3.PermissionFullControl]
+		putInput.GrantRead = grants[s3.PermissionRead]
+		putInput.GrantReadACP = grants[s3.PermissionReadAcp]
+		putInput.GrantWriteACP = grants[s3.PermissionWriteAcp]
+	} else {
+		putInput.ACL = aws.String(d.Get("acl").(string))
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		putInput.Metadata = stringMapToPointers(v.(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("storage_class"); ok {
 		putInput.StorageClass = aws.String(v.(string))
 	}
@@ -247,19 +390,204 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.WebsiteRedirectLocation = aws.String(v.(string))
 	}
 
-	resp, err := s3conn.PutObject(putInput)
+	uploader := s3manager.NewUploaderWithClient(s3conn, func(u *s3manager.Uploader) {
+		// Unless a part size is configured, objects up to the size limit of a
+		// single PUT are uploaded in one request, which keeps their ETag the
+		// MD5 digest of the content.
+		u.PartSize = s3ObjectMaxSinglePutSize
+		if v, ok := d.GetOk("part_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+		u.Concurrency = d.Get("concurrency").(int)
+	})
+
+	resp, err := uploader.Upload(putInput)
 	if err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
 
-	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(*resp.ETag, `"`))
-
-	d.Set("version_id", resp.VersionId)
+	d.Set("source_hash", sourceHash)
+	d.Set("version_id", resp.VersionID)
 	d.SetId(key)
 	return resourceAwsS3BucketObjectRead(d, meta)
 }
 
+func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	// A new body has to be uploaded, which also applies every other argument.
+	for _, k := range []string{"source", "source_hash", "content", "content_base64", "etag"} {
+		if d.HasChange(k) {
+			return resourceAwsS3BucketObjectPut(d, meta)
+		}
+	}
+
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	// Object metadata can only be changed by copying the object onto itself.
+	copyInPlace := false
+	for _, k := range []string{"cache_control", "content_disposition", "content_encoding", "content_language",
+		"content_type", "metadata", "storage_class", "server_side_encryption", "kms_key_id", "website_redirect"} {
+		if d.HasChange(k) {
+			copyInPlace = true
+			break
+		}
+	}
+
+	if copyInPlace {
+		head, err := s3conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("error reading S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+		}
+
+		// CopyObject is limited to objects of up to 5 GB.
+		if aws.Int64Value(head.ContentLength) > s3ObjectMaxCopySize {
+			return resourceAwsS3BucketObjectPut(d, meta)
+		}
+
+		if err := resourceAwsS3BucketObjectCopyInPlace(s3conn, d); err != nil {
+			return err
+		}
+	} else if d.HasChange("acl") || d.HasChange("grant") {
+		input := &s3.PutObjectAclInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+
+		if v, ok := d.GetOk("grant"); ok && v.(*schema.Set).Len() > 0 {
+			grants := expandS3BucketObjectGrantHeaders(v.(*schema.Set).List())
+			input.GrantFullControl = grants[s3.PermissionFullControl]
+			input.GrantRead = grants[s3.PermissionRead]
+			input.GrantReadACP = grants[s3.PermissionReadAcp]
+			input.GrantWriteACP = grants[s3.PermissionWriteAcp]
+		} else {
+			input.ACL = aws.String(d.Get("acl").(string))
+		}
+
+		log.Printf("[DEBUG] Putting S3 Bucket (%s) Object (%s) ACL: %s", bucket, key, input)
+		if _, err := s3conn.PutObjectAcl(input); err != nil {
+			return fmt.Errorf("error putting S3 Bucket (%s) Object (%s) ACL: %s", bucket, key, err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if meta.(*AWSClient).IsChinaCloud() {
+			return fmt.Errorf("This region does not allow for tags on S3 objects")
+		}
+
+		if err := setTagsS3BucketObject(s3conn, d); err != nil {
+			return fmt.Errorf("error updating S3 Bucket (%s) Object (%s) tags: %s", bucket, key, err)
+		}
+	}
+
+	return resourceAwsS3BucketObjectRead(d, meta)
+}
+
+// s3ObjectMaxCopySize is the largest object a single CopyObject request can copy.
+const s3ObjectMaxCopySize = 5 * 1024 * 1024 * 1024
+
+// resourceAwsS3BucketObjectCopyInPlace copies the object onto itself, replacing
+// its metadata with the configured values. The ACL is not copied, so it is set again.
+func resourceAwsS3BucketObjectCopyInPlace(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	input := &s3.CopyObjectInput{
+		Bucket:            aws.String(bucket),
+		Key:               aws.String(key),
+		CopySource:        aws.String(url.PathEscape(bucket + "/" + key)),
+		MetadataDirective: aws.String(s3.MetadataDirectiveReplace),
+		TaggingDirective:  aws.String(s3.TaggingDirectiveCopy),
+	}
+
+	if v, ok := d.GetOk("grant"); ok && v.(*schema.Set).Len() > 0 {
+		grants := expandS3BucketObjectGrantHeaders(v.(*schema.Set).List())
+		input.GrantFullControl = grants[s3.PermissionFullControl]
+		input.GrantRead = grants[s3.PermissionRead]
+		input.GrantReadACP = grants[s3.PermissionReadAcp]
+		input.GrantWriteACP = grants[s3.PermissionWriteAcp]
+	} else {
+		input.ACL = aws.String(d.Get("acl").(string))
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		input.Metadata = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_type"); ok {
+		input.ContentType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_encoding"); ok {
+		input.ContentEncoding = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_language"); ok {
+		input.ContentLanguage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_disposition"); ok {
+		input.ContentDisposition = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	if v, ok := d.GetOk("website_redirect"); ok {
+		input.WebsiteRedirectLocation = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Copying S3 Bucket (%s) Object (%s) in place: %s", bucket, key, input)
+	if _, err := s3conn.CopyObject(input); err != nil {
+		return fmt.Errorf("error copying S3 Bucket (%s) Object (%s) in place: %s", bucket, key, err)
+	}
+
+	return nil
+}
+
+func setTagsS3BucketObject(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	tags := tagsFromMapS3(d.Get("tags").(map[string]interface{}))
+	if len(tags) == 0 {
+		log.Printf("[DEBUG] Deleting S3 Bucket (%s) Object (%s) tags", bucket, key)
+		_, err := s3conn.DeleteObjectTagging(&s3.DeleteObjectTaggingInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		return err
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket (%s) Object (%s) tags: %#v", bucket, key, tags)
+	_, err := s3conn.PutObjectTagging(&s3.PutObjectTaggingInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Tagging: &s3.Tagging{
+			TagSet: tags,
+		},
+	})
+	return err
+}
+
 func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
@@ -294,6 +622,29 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("server_side_encryption", resp.ServerSideEncryption)
 	d.Set("website_redirect", resp.WebsiteRedirectLocation)
 
+	// S3 returns the metadata keys in canonical header form; they are configured in lower case.
+	metadata := make(map[string]interface{}, len(resp.Metadata))
+	for k, v := range resp.Metadata {
+		metadata[strings.ToLower(k)] = aws.StringValue(v)
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return fmt.Errorf("error setting metadata: %s", err)
+	}
+
+	// Explicit grants are only tracked when configured, otherwise the canned ACL is.
+	if v, ok := d.GetOk("grant"); ok && v.(*schema.Set).Len() > 0 {
+		aclResp, err := s3conn.GetObjectAcl(&s3.GetObjectAclInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("error reading S3 Bucket (%s) Object (%s) ACL: %s", bucket, key, err)
+		}
+		if err := d.Set("grant", flattenS3BucketObjectGrants(aclResp.Grants)); err != nil {
+			return fmt.Errorf("error setting grant: %s", err)
+		}
+	}
+
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if resp.SSEKMSKeyId != nil {
 		// retrieve S3 KMS Default Master Key
@@ -377,3 +728,68 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 
 	return nil
 }
+
+// expandS3BucketObjectGrantHeaders returns the value of the x-amz-grant-*
+// header for each permission granted by the grant blocks.
+func expandS3BucketObjectGrantHeaders(grants []interface{}) map[string]*string {
+	grantees := make(map[string][]string)
+	for _, raw := range grants {
+		grant := raw.(map[string]interface{})
+
+		var grantee string
+		switch grant["type"].(string) {
+		case s3.TypeCanonicalUser:
+			grantee = fmt.Sprintf("id=%q", grant["id"].(string))
+		case s3.TypeGroup:
+			grantee = fmt.Sprintf("uri=%q", grant["uri"].(string))
+		}
+
+		for _, permission := range grant["permissions"].(*schema.Set).List() {
+			grantees[permission.(string)] = append(grantees[permission.(string)], grantee)
+		}
+	}
+
+	headers := make(map[string]*string, len(grantees))
+	for permission, list := range grantees {
+		sort.Strings(list)
+		headers[permission] = aws.String(strings.Join(list, ", "))
+	}
+
+	return headers
+}
+
+func flattenS3BucketObjectGrants(grants []*s3.Grant) []interface{} {
+	permissions := make(map[string]*schema.Set)
+	var order []string
+	for _, grant := range grants {
+		if grant.Grantee == nil {
+			continue
+		}
+
+		grantType := aws.StringValue(grant.Grantee.Type)
+		id := aws.StringValue(grant.Grantee.ID) + aws.StringValue(grant.Grantee.URI)
+		k := grantType + ":" + id
+		if _, ok := permissions[k]; !ok {
+			permissions[k] = schema.NewSet(schema.HashString, nil)
+			order = append(order, k)
+		}
+		permissions[k].Add(aws.StringValue(grant.Permission))
+	}
+
+	result := make([]interface{}, 0, len(order))
+	for _, k := range order {
+		parts := strings.SplitN(k, ":", 2)
+		m := map[string]interface{}{
+			"type":        parts[0],
+			"permissions": permissions[k],
+		}
+		if parts[0] == s3.TypeGroup {
+			m["uri"] = parts[1]
+		} else {
+			m["id"] = parts[1]
+		}
+		result = append(result, m)
+	}
+
+	return result
+}
@@ -1,15 +1,18 @@
 package aws
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -188,6 +191,78 @@ func TestAccAWSS3BucketObject_updates(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	rInt := acctest.RandInt()
+	// 6 MB spans two parts of the minimum part size.
+	err = ioutil.WriteFile(tmpFile.Name(), bytes.Repeat([]byte("a"), 6*1024*1024), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfig_multipart(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestMatchResourceAttr("aws_s3_bucket_object.object", "etag", regexp.MustCompile(`-2$`)),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "source_hash", "7aaab8be604cf73f796ea3836dc9f7f9e320e63313a6db99a69f3f2b211dfcb2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					err = ioutil.WriteFile(tmpFile.Name(), bytes.Repeat([]byte("b"), 6*1024*1024), 0644)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAWSS3BucketObjectConfig_multipart(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					testAccCheckAWSS3BucketObjectBody(&obj, bytes.Repeat([]byte("b"), 6*1024*1024)),
+				),
+			},
+		},
+	})
+}
+
+func TestS3ObjectSourceHash(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-s3-obj-source-hash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	err = ioutil.WriteFile(tmpFile.Name(), []byte("some_bucket_content"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash, err := s3ObjectSourceHash(tmpFile.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "fe942f6e493a5cb68b4ee1e7d1563481bc44230d060069592f8dcc0bf13a6557"
+	if hash != expected {
+		t.Fatalf("Expected hash %s, got %s", expected, hash)
+	}
+
+	if _, err := s3ObjectSourceHash(tmpFile.Name() + "-missing"); err == nil {
+		t.Fatal("Expected an error for a missing source")
+	}
+}
+
 func TestAccAWSS3BucketObject_updatesWithVersioning(t *testing.T) {
 	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-updates-w-versions")
 	if err != nil {
@@ -299,6 +374,22 @@ func testAccCheckAWSS3BucketObjectExists(n string, obj *s3.GetObjectOutput) reso
 	}
 }
 
+func testAccCheckAWSS3BucketObjectBody(obj *s3.GetObjectOutput, want []byte) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		body, err := ioutil.ReadAll(obj.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read body: %s", err)
+		}
+		obj.Body.Close()
+
+		if !bytes.Equal(body, want) {
+			return fmt.Errorf("wrong result body (%d bytes); want %d bytes", len(body), len(want))
+		}
+
+		return nil
+	}
+}
+
 func TestAccAWSS3BucketObject_kms(t *testing.T) {
 	rInt := acctest.RandInt()
 	var obj s3.GetObjectOutput
@@ -547,6 +638,54 @@ func TestAccAWSS3BucketObject_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketObject_etagLargeSource(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-etag-large")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	rInt := acctest.RandInt()
+	// Larger than the minimum part size, but uploaded in one part unless
+	// part_size is configured.
+	err = ioutil.WriteFile(tmpFile.Name(), bytes.Repeat([]byte("a"), 6*1024*1024), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfig_updates(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "etag", "0df68495b5ef53a8ce2a9d4076cc6252"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSS3BucketObjectConfig_multipart(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket      = "${aws_s3_bucket.object_bucket.bucket}"
+  key         = "test-key"
+  source      = "%s"
+  part_size   = 5242880
+  concurrency = 2
+}
+`, randInt, source)
+}
+
 func testAccAWSS3BucketObjectConfigSource(randInt int, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket" {
@@ -698,6 +837,151 @@ resource "aws_s3_bucket_object" "object" {
 `, randInt, storage_class)
 }
 
+func TestAccAWSS3BucketObject_metadataUpdatedInPlace(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_object.object"
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfig_metadata(rInt, "300", "Value One"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.cdn-ttl", "300"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.team", "web"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "Value One"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketObjectConfig_metadata(rInt, "600", "Value Two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					testAccCheckAWSS3BucketObjectBody(&obj, []byte("stuff")),
+					resource.TestCheckResourceAttr(resourceName, "metadata.cdn-ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "Value Two"),
+					resource.TestCheckResourceAttr(resourceName, "acl", "public-read"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObject_grant(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_object.object"
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfig_grant(rInt, `"READ"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketObjectConfig_grant(rInt, `"READ", "READ_ACP"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandS3BucketObjectGrantHeaders(t *testing.T) {
+	grants := []interface{}{
+		map[string]interface{}{
+			"type":        s3.TypeCanonicalUser,
+			"id":          "abc123",
+			"permissions": schema.NewSet(schema.HashString, []interface{}{s3.PermissionFullControl}),
+		},
+		map[string]interface{}{
+			"type":        s3.TypeGroup,
+			"uri":         "http://acs.amazonaws.com/groups/global/AllUsers",
+			"permissions": schema.NewSet(schema.HashString, []interface{}{s3.PermissionRead, s3.PermissionFullControl}),
+		},
+	}
+
+	expected := map[string]string{
+		s3.PermissionFullControl: `id="abc123", uri="http://acs.amazonaws.com/groups/global/AllUsers"`,
+		s3.PermissionRead:        `uri="http://acs.amazonaws.com/groups/global/AllUsers"`,
+	}
+
+	headers := expandS3BucketObjectGrantHeaders(grants)
+	if len(headers) != len(expected) {
+		t.Fatalf("Expected %d headers, got %d: %#v", len(expected), len(headers), headers)
+	}
+	for permission, value := range expected {
+		if got := aws.StringValue(headers[permission]); got != value {
+			t.Errorf("%s: expected %s, got %s", permission, value, got)
+		}
+	}
+}
+
+func testAccAWSS3BucketObjectConfig_metadata(randInt int, ttl, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket  = "${aws_s3_bucket.object_bucket.bucket}"
+  key     = "test-key"
+  content = "stuff"
+  acl     = "public-read"
+
+  metadata {
+    cdn-ttl = "%s"
+    team    = "web"
+  }
+
+  tags {
+    Key1 = "%s"
+  }
+}
+`, randInt, ttl, tagValue)
+}
+
+func testAccAWSS3BucketObjectConfig_grant(randInt int, permissions string) string {
+	return fmt.Sprintf(`
+data "aws_canonical_user_id" "current" {}
+
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket  = "${aws_s3_bucket.object_bucket.bucket}"
+  key     = "test-key"
+  content = "stuff"
+
+  grant {
+    id          = "${data.aws_canonical_user_id.current.id}"
+    type        = "CanonicalUser"
+    permissions = ["FULL_CONTROL"]
+  }
+
+  grant {
+    uri         = "http://acs.amazonaws.com/groups/global/AllUsers"
+    type        = "Group"
+    permissions = [%s]
+  }
+}
+`, randInt, permissions)
+}
+
 func testAccAWSS3BucketObjectConfig_withTags(randInt int) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket_2" {
@@ -0,0 +1,634 @@
+package aws
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mitchellh/go-homedir"
+)
+
+func resourceAwsS3BucketObjects() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketObjectsPut,
+		Read:   resourceAwsS3BucketObjectsRead,
+		Update: resourceAwsS3BucketObjectsPut,
+		Delete: resourceAwsS3BucketObjectsDelete,
+
+		CustomizeDiff: resourceAwsS3BucketObjectsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"acl": {
+				Type:     schema.TypeString,
+				Default:  "private",
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectCannedACLPrivate,
+					s3.ObjectCannedACLPublicRead,
+					s3.ObjectCannedACLPublicReadWrite,
+					s3.ObjectCannedACLAuthenticatedRead,
+					s3.ObjectCannedACLAwsExecRead,
+					s3.ObjectCannedACLBucketOwnerRead,
+					s3.ObjectCannedACLBucketOwnerFullControl,
+				}, false),
+			},
+
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.StorageClassStandard,
+					s3.StorageClassReducedRedundancy,
+					s3.StorageClassOnezoneIa,
+					s3.StorageClassStandardIa,
+				}, false),
+			},
+
+			"server_side_encryption": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ServerSideEncryptionAes256,
+					s3.ServerSideEncryptionAwsKms,
+				}, false),
+			},
+
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3manager.DefaultUploadPartSize,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
+
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"manifest": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// s3BucketObjectsFile is a single file found under source_dir.
+type s3BucketObjectsFile struct {
+	path        string
+	key         string
+	etag        string
+	contentType string
+}
+
+func resourceAwsS3BucketObjectsCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	for _, k := range []string{"source_dir", "key_prefix", "include", "exclude", "part_size"} {
+		if !diff.NewValueKnown(k) {
+			return diff.SetNewComputed("manifest")
+		}
+	}
+
+	files, err := s3BucketObjectsWalk(
+		diff.Get("source_dir").(string),
+		diff.Get("key_prefix").(string),
+		expandStringList(diff.Get("include").([]interface{})),
+		expandStringList(diff.Get("exclude").([]interface{})),
+		diff.Get("content_types").(map[string]interface{}),
+		int64(diff.Get("part_size").(int)),
+	)
+	if err != nil {
+		return err
+	}
+
+	manifest := make(map[string]interface{}, len(files))
+	for _, f := range files {
+		manifest[f.key] = f.etag
+	}
+
+	if !reflect.DeepEqual(manifest, diff.Get("manifest").(map[string]interface{})) {
+		return diff.SetNew("manifest", manifest)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	files, err := s3BucketObjectsWalk(
+		d.Get("source_dir").(string),
+		keyPrefix,
+		expandStringList(d.Get("include").([]interface{})),
+		expandStringList(d.Get("exclude").([]interface{})),
+		d.Get("content_types").(map[string]interface{}),
+		int64(d.Get("part_size").(int)),
+	)
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("manifest")
+	oldManifest := o.(map[string]interface{})
+	if d.IsNewResource() {
+		oldManifest = map[string]interface{}{}
+	}
+
+	// Object settings apply to every key, so a change re-uploads everything.
+	uploadAll := d.HasChange("acl") || d.HasChange("cache_control") || d.HasChange("content_types") ||
+		d.HasChange("storage_class") || d.HasChange("server_side_encryption") || d.HasChange("kms_key_id")
+
+	manifest := make(map[string]interface{}, len(files))
+	var uploads []*s3BucketObjectsFile
+	for _, f := range files {
+		if etag, ok := oldManifest[f.key]; ok && etag.(string) == f.etag && !uploadAll {
+			manifest[f.key] = f.etag
+			continue
+		}
+		uploads = append(uploads, f)
+	}
+
+	uploader := s3manager.NewUploaderWithClient(s3conn, func(u *s3manager.Uploader) {
+		u.PartSize = int64(d.Get("part_size").(int))
+	})
+
+	uploaded, uploadErr := s3BucketObjectsUpload(uploader, uploads, d.Get("concurrency").(int), func(f *s3BucketObjectsFile) *s3manager.UploadInput {
+		input := &s3manager.UploadInput{
+			Bucket:      aws.String(bucket),
+			Key:         aws.String(f.key),
+			ACL:         aws.String(d.Get("acl").(string)),
+			ContentType: aws.String(f.contentType),
+		}
+
+		if v, ok := d.GetOk("cache_control"); ok {
+			input.CacheControl = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("storage_class"); ok {
+			input.StorageClass = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			input.ServerSideEncryption = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("kms_key_id"); ok {
+			input.SSEKMSKeyId = aws.String(v.(string))
+			input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+		}
+
+		return input
+	})
+	for _, f := range uploaded {
+		manifest[f.key] = f.etag
+	}
+
+	if d.IsNewResource() {
+		d.SetId(fmt.Sprintf("%s/%s", bucket, keyPrefix))
+	}
+
+	if uploadErr != nil {
+		// Keep track of everything that may still exist in the bucket so that a
+		// later apply can finish the job (or a destroy can clean it up).
+		for k, v := range oldManifest {
+			if _, ok := manifest[k]; !ok {
+				manifest[k] = v
+			}
+		}
+		if err := d.Set("manifest", manifest); err != nil {
+			return fmt.Errorf("error setting manifest: %s", err)
+		}
+		return uploadErr
+	}
+
+	var removed []string
+	for k := range oldManifest {
+		if _, ok := manifest[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+
+	if err := s3BucketObjectsDeleteKeys(s3conn, bucket, removed); err != nil {
+		for k, v := range oldManifest {
+			if _, ok := manifest[k]; !ok {
+				manifest[k] = v
+			}
+		}
+		if err := d.Set("manifest", manifest); err != nil {
+			return fmt.Errorf("error setting manifest: %s", err)
+		}
+		return err
+	}
+
+	if err := d.Set("manifest", manifest); err != nil {
+		return fmt.Errorf("error setting manifest: %s", err)
+	}
+
+	return resourceAwsS3BucketObjectsRead(d, meta)
+}
+
+func resourceAwsS3BucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if v, ok := d.GetOk("key_prefix"); ok {
+		input.Prefix = aws.String(v.(string))
+	}
+
+	remote := make(map[string]string)
+	err := s3conn.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			remote[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return !lastPage
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing objects (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error listing S3 Bucket (%s) objects: %s", bucket, err)
+	}
+
+	// ETags of SSE-KMS encrypted objects are not an MD5 digest of the content,
+	// so only the presence of those objects can be verified.
+	_, kms := d.GetOk("kms_key_id")
+	kms = kms || d.Get("server_side_encryption").(string) == s3.ServerSideEncryptionAwsKms
+
+	manifest := make(map[string]interface{})
+	for key, etag := range d.Get("manifest").(map[string]interface{}) {
+		remoteETag, ok := remote[key]
+		if !ok {
+			log.Printf("[DEBUG] S3 Bucket (%s) object (%s) not found", bucket, key)
+			continue
+		}
+		if kms {
+			manifest[key] = etag
+		} else {
+			manifest[key] = remoteETag
+		}
+	}
+
+	if err := d.Set("manifest", manifest); err != nil {
+		return fmt.Errorf("error setting manifest: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	var keys []string
+	for k := range d.Get("manifest").(map[string]interface{}) {
+		keys = append(keys, k)
+	}
+
+	err := s3BucketObjectsDeleteKeys(s3conn, bucket, keys)
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+
+	return err
+}
+
+// s3BucketObjectsUpload uploads files using a pool of workers and returns the
+// files that were uploaded successfully along with any upload errors.
+func s3BucketObjectsUpload(uploader *s3manager.Uploader, files []*s3BucketObjectsFile, concurrency int, inputFunc func(*s3BucketObjectsFile) *s3manager.UploadInput) ([]*s3BucketObjectsFile, error) {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		uploaded []*s3BucketObjectsFile
+		errs     *multierror.Error
+	)
+
+	queue := make(chan *s3BucketObjectsFile)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for f := range queue {
+				err := s3BucketObjectsUploadFile(uploader, f, inputFunc(f))
+
+				mu.Lock()
+				if err != nil {
+					errs = multierror.Append(errs, err)
+				} else {
+					uploaded = append(uploaded, f)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, f := range files {
+		queue <- f
+	}
+	close(queue)
+	wg.Wait()
+
+	return uploaded, errs.ErrorOrNil()
+}
+
+func s3BucketObjectsUploadFile(uploader *s3manager.Uploader, f *s3BucketObjectsFile, input *s3manager.UploadInput) error {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return fmt.Errorf("error opening S3 bucket object source (%s): %s", f.path, err)
+	}
+	defer file.Close()
+
+	input.Body = file
+
+	log.Printf("[DEBUG] Uploading S3 Bucket (%s) object (%s) from %s", aws.StringValue(input.Bucket), f.key, f.path)
+	if _, err := uploader.Upload(input); err != nil {
+		return fmt.Errorf("error uploading S3 Bucket (%s) object (%s): %s", aws.StringValue(input.Bucket), f.key, err)
+	}
+
+	return nil
+}
+
+func s3BucketObjectsDeleteKeys(s3conn *s3.S3, bucket string, keys []string) error {
+	sort.Strings(keys)
+
+	// DeleteObjects accepts at most 1000 keys per request.
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > 1000 {
+			n = 1000
+		}
+
+		objects := make([]*s3.ObjectIdentifier, 0, n)
+		for _, key := range keys[:n] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		keys = keys[n:]
+
+		log.Printf("[DEBUG] Deleting %d S3 Bucket (%s) objects", len(objects), bucket)
+		out, err := s3conn.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting S3 Bucket (%s) objects: %s", bucket, err)
+		}
+
+		var errs *multierror.Error
+		for _, e := range out.Errors {
+			errs = multierror.Append(errs, fmt.Errorf("error deleting S3 Bucket (%s) object (%s): %s: %s",
+				bucket, aws.StringValue(e.Key), aws.StringValue(e.Code), aws.StringValue(e.Message)))
+		}
+		if err := errs.ErrorOrNil(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// s3BucketObjectsWalk returns the files under dir matching the include and
+// exclude globs, keyed by their slash-separated path relative to dir.
+func s3BucketObjectsWalk(dir, keyPrefix string, include, exclude []*string, contentTypes map[string]interface{}, partSize int64) ([]*s3BucketObjectsFile, error) {
+	root, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source_dir (%s): %s", dir, err)
+	}
+
+	includes, err := s3BucketObjectsGlobsToRegexps(include)
+	if err != nil {
+		return nil, err
+	}
+	excludes, err := s3BucketObjectsGlobsToRegexps(exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*s3BucketObjectsFile
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if len(includes) > 0 && !s3BucketObjectsMatchAny(includes, rel) {
+			return nil
+		}
+		if s3BucketObjectsMatchAny(excludes, rel) {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		etag, err := s3ObjectETag(file, info.Size(), partSize)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, &s3BucketObjectsFile{
+			path:        path,
+			key:         keyPrefix + rel,
+			etag:        etag,
+			contentType: s3ObjectContentType(rel, contentTypes),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading source_dir (%s): %s", dir, err)
+	}
+
+	return files, nil
+}
+
+// s3ObjectContentType returns the MIME type for name based on its extension,
+// preferring any override in contentTypes.
+func s3ObjectContentType(name string, contentTypes map[string]interface{}) string {
+	ext := strings.ToLower(filepath.Ext(name))
+	if ext == "" {
+		return "application/octet-stream"
+	}
+
+	for _, k := range []string{ext, strings.TrimPrefix(ext, ".")} {
+		if v, ok := contentTypes[k]; ok {
+			return v.(string)
+		}
+	}
+
+	if v := mime.TypeByExtension(ext); v != "" {
+		return v
+	}
+
+	return "application/octet-stream"
+}
+
+// s3ObjectETag returns the ETag S3 assigns to an object uploaded by the
+// s3manager uploader with the given part size: the MD5 digest of the content
+// for single part uploads, or the digest of the concatenated part digests
+// followed by the part count for multipart uploads.
+func s3ObjectETag(r io.Reader, size, partSize int64) (string, error) {
+	// Mirror the uploader, which grows the part size to stay within the part limit.
+	if size/partSize >= int64(s3manager.MaxUploadParts) {
+		partSize = (size / int64(s3manager.MaxUploadParts)) + 1
+	}
+
+	if size <= partSize {
+		h := md5.New()
+		if _, err := io.Copy(h, r); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	var digests []byte
+	parts := 0
+	for remaining := size; remaining > 0; remaining -= partSize {
+		n := partSize
+		if remaining < n {
+			n = remaining
+		}
+
+		h := md5.New()
+		if _, err := io.CopyN(h, r, n); err != nil {
+			return "", err
+		}
+		digests = append(digests, h.Sum(nil)...)
+		parts++
+	}
+
+	sum := md5.Sum(digests)
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts), nil
+}
+
+// s3BucketObjectsGlobsToRegexps compiles glob patterns in which "*" and "?"
+// match within a single path segment and "**" matches across segments.
+func s3BucketObjectsGlobsToRegexps(globs []*string) ([]*regexp.Regexp, error) {
+	var res []*regexp.Regexp
+	for _, g := range globs {
+		glob := aws.StringValue(g)
+
+		var b strings.Builder
+		b.WriteString("^")
+		for i := 0; i < len(glob); i++ {
+			switch c := glob[i]; c {
+			case '*':
+				if i+1 < len(glob) && glob[i+1] == '*' {
+					i++
+					if i+1 < len(glob) && glob[i+1] == '/' {
+						// "**/" also matches no directory at all.
+						i++
+						b.WriteString("(.*/)?")
+					} else {
+						b.WriteString(".*")
+					}
+				} else {
+					b.WriteString("[^/]*")
+				}
+			case '?':
+				b.WriteString("[^/]")
+			default:
+				b.WriteString(regexp.QuoteMeta(string(c)))
+			}
+		}
+		b.WriteString("$")
+
+		re, err := regexp.Compile(b.String())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing glob (%s): %s", glob, err)
+		}
+		res = append(res, re)
+	}
+
+	return res, nil
+}
+
+func s3BucketObjectsMatchAny(res []*regexp.Regexp, name string) bool {
+	for _, re := range res {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestS3BucketObjectsGlobsToRegexps(t *testing.T) {
+	cases := []struct {
+		Glob    string
+		Name    string
+		Matches bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "docs/index.html", false},
+		{"**/*.html", "index.html", true},
+		{"**/*.html", "docs/a/index.html", true},
+		{"docs/**", "docs/a/b.css", true},
+		{"docs/**", "img/a.png", false},
+		{"img/?.png", "img/a.png", true},
+		{"img/?.png", "img/ab.png", false},
+		{"a+b.txt", "a+b.txt", true},
+		{"a+b.txt", "aab.txt", false},
+	}
+
+	for _, tc := range cases {
+		res, err := s3BucketObjectsGlobsToRegexps([]*string{aws.String(tc.Glob)})
+		if err != nil {
+			t.Fatalf("%s: %s", tc.Glob, err)
+		}
+		if got := s3BucketObjectsMatchAny(res, tc.Name); got != tc.Matches {
+			t.Errorf("%s matching %s: got %t, want %t", tc.Glob, tc.Name, got, tc.Matches)
+		}
+	}
+}
+
+func TestS3ObjectContentType(t *testing.T) {
+	overrides := map[string]interface{}{
+		".md":  "text/markdown",
+		"wasm": "application/wasm",
+	}
+
+	cases := map[string]string{
+		"index.html":   "text/html; charset=utf-8",
+		"README.md":    "text/markdown",
+		"app.WASM":     "application/wasm",
+		"image.png":    "image/png",
+		"Makefile":     "application/octet-stream",
+		"data.unknown": "application/octet-stream",
+	}
+
+	for name, expected := range cases {
+		if got := s3ObjectContentType(name, overrides); got != expected {
+			t.Errorf("%s: got %q, want %q", name, got, expected)
+		}
+	}
+}
+
+func TestS3ObjectETag(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 1100)
+
+	single := md5.Sum(content)
+	etag, err := s3ObjectETag(bytes.NewReader(content), int64(len(content)), int64(len(content)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := hex.EncodeToString(single[:]); etag != expected {
+		t.Errorf("single part: got %s, want %s", etag, expected)
+	}
+
+	var digests []byte
+	for _, part := range [][]byte{content[:5000], content[5000:10000], content[10000:]} {
+		sum := md5.Sum(part)
+		digests = append(digests, sum[:]...)
+	}
+	multi := md5.Sum(digests)
+
+	etag, err = s3ObjectETag(bytes.NewReader(content), int64(len(content)), 5000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := hex.EncodeToString(multi[:]) + "-3"; etag != expected {
+		t.Errorf("multipart: got %s, want %s", etag, expected)
+	}
+}
+
+func TestAccAWSS3BucketObjects_basic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-acc-s3-objects")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeFile := func(name, content string) {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "<html></html>")
+	writeFile("css/site.css", "body {}")
+	writeFile("notes.txt", "excluded")
+
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_objects.site"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/css/site.css"),
+					testAccCheckAWSS3BucketObjectsContentType(resourceName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckAWSS3BucketObjectsContentType(resourceName, "site/css/site.css", "text/css; charset=utf-8"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<html><body></body></html>")
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+					writeFile("data/app.json", "{}")
+				},
+				Config: testAccAWSS3BucketObjectsConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/data/app.json"),
+					testAccCheckAWSS3BucketObjectsContentType(resourceName, "site/data/app.json", "application/json"),
+					testAccCheckAWSS3BucketObjectsKeyGone(resourceName, "site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketObjectsContentType(n, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+		out, err := s3conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("S3 object (%s) not found: %s", key, err)
+		}
+
+		if got := aws.StringValue(out.ContentType); got != contentType {
+			return fmt.Errorf("S3 object (%s) content type: got %q, want %q", key, got, contentType)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsKeyGone(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+		_, err := s3conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+		if err == nil {
+			return fmt.Errorf("S3 object (%s) still exists", key)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsDestroy(s *terraform.State) error {
+	s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_objects" {
+			continue
+		}
+
+		out, err := s3conn.ListObjectsV2(&s3.ListObjectsV2Input{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Prefix: aws.String(rs.Primary.Attributes["key_prefix"]),
+		})
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if len(out.Contents) > 0 {
+			return fmt.Errorf("S3 Bucket (%s) objects still exist", rs.Primary.Attributes["bucket"])
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSS3BucketObjectsConfig(randInt int, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_objects" "site" {
+  bucket     = "${aws_s3_bucket.bucket.bucket}"
+  source_dir = %q
+  key_prefix = "site/"
+  exclude    = ["*.txt"]
+}
+`, randInt, dir)
+}
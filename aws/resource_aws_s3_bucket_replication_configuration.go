@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketReplicationConfigurationPut,
+		Read:   resourceAwsS3BucketReplicationConfigurationRead,
+		Update: resourceAwsS3BucketReplicationConfigurationPut,
+		Delete: resourceAwsS3BucketReplicationConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsS3BucketReplicationConfigurationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      rulesHash,
+				Elem:     s3BucketReplicationRuleResource(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketReplicationConfigurationCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("rules") && !diff.HasChange("bucket") {
+		return nil
+	}
+
+	// The versioning of the buckets may be changed by other resources in the
+	// same plan, so it is only checked on apply.
+	return validateS3BucketReplicationRules(diff.Get("rules").(*schema.Set).List())
+}
+
+func resourceAwsS3BucketReplicationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	hasVersioning, err := s3BucketVersioningEnabled(conn, bucket)
+	if err != nil {
+		return err
+	}
+
+	if !hasVersioning {
+		return fmt.Errorf("versioning must be enabled on S3 Bucket (%s) to allow replication", bucket)
+	}
+
+	replicationConfiguration := map[string]interface{}{
+		"role":  d.Get("role").(string),
+		"rules": d.Get("rules").(*schema.Set),
+	}
+
+	if err := resourceAwsS3BucketReplicationConfigurationUpdate(conn, bucket, []interface{}{replicationConfiguration}); err != nil {
+		return err
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	resp, err := conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "ReplicationConfigurationNotFoundError", "") {
+		log.Printf("[WARN] S3 Bucket replication configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket replication configuration (%s): %s", d.Id(), err)
+	}
+
+	replicationConfiguration := flattenAwsS3BucketReplicationConfiguration(resp.ReplicationConfiguration)
+	if len(replicationConfiguration) == 0 {
+		log.Printf("[WARN] S3 Bucket replication configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("role", replicationConfiguration[0]["role"])
+
+	if err := d.Set("rules", replicationConfiguration[0]["rules"]); err != nil {
+		return fmt.Errorf("error setting rules: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	_, err := conn.DeleteBucketReplication(&s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket replication configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"text/template"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -1034,6 +1035,357 @@ func TestAccAWSS3Bucket_ReplicationExpectVersioningValidationError(t *testing.T)
 	})
 }
 
+func TestAccAWSS3Bucket_ReplicationEnableVersioning(t *testing.T) {
+	rInt := acctest.RandInt()
+	region := testAccGetRegion()
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionsPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSS3BucketDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketConfigReplicationWithoutVersioning(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExistsWithProvider("aws_s3_bucket.bucket", testAccAwsRegionProviderFunc(region, &providers)),
+					testAccCheckAWSS3BucketExistsWithProvider("aws_s3_bucket.destination", testAccAwsRegionProviderFunc("eu-west-1", &providers)),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "versioning.0.enabled", "false"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.destination", "versioning.0.enabled", "false"),
+				),
+			},
+			{
+				// Versioning of both existing buckets is enabled together with replication
+				Config: testAccAWSS3BucketConfigReplicationWithConfiguration(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "versioning.0.enabled", "true"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.destination", "versioning.0.enabled", "true"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.0.rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3Bucket_ReplicationSchemaV2(t *testing.T) {
+	rInt := acctest.RandInt()
+	region := testAccGetRegion()
+	partition := testAccGetPartition()
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionsPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSS3BucketDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketConfigReplicationWithV2Filter(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExistsWithProvider("aws_s3_bucket.bucket", testAccAwsRegionProviderFunc(region, &providers)),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.0.rules.#", "1"),
+					testAccCheckAWSS3BucketReplicationRules(
+						"aws_s3_bucket.bucket",
+						testAccAwsRegionProviderFunc(region, &providers),
+						[]*s3.ReplicationRule{
+							{
+								ID: aws.String("foobar"),
+								Destination: &s3.Destination{
+									Bucket:       aws.String(fmt.Sprintf("arn:%s:s3:::tf-test-bucket-destination-%d", partition, rInt)),
+									StorageClass: aws.String(s3.ObjectStorageClassStandard),
+								},
+								Filter: &s3.ReplicationRuleFilter{
+									And: &s3.ReplicationRuleAndOperator{
+										Prefix: aws.String("foo"),
+										Tags: []*s3.Tag{
+											{
+												Key:   aws.String("ReplicateMe"),
+												Value: aws.String("Yes"),
+											},
+										},
+									},
+								},
+								Priority: aws.Int64(10),
+								DeleteMarkerReplication: &s3.DeleteMarkerReplication{
+									Status: aws.String(s3.DeleteMarkerReplicationStatusDisabled),
+								},
+								Status: aws.String(s3.ReplicationRuleStatusEnabled),
+							},
+						},
+					),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketConfigReplicationWithAccessControlTranslation(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExistsWithProvider("aws_s3_bucket.bucket", testAccAwsRegionProviderFunc(region, &providers)),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.0.rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3Bucket_ReplicationExpectSseKmsValidationError(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionsPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSS3BucketDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSS3BucketConfigReplicationSseKmsNoReplicaKey(rInt),
+				ExpectError: regexp.MustCompile(`replica_kms_key_id must be set to replicate SSE-KMS encrypted objects`),
+			},
+		},
+	})
+}
+
+func TestValidateS3BucketReplicationRules(t *testing.T) {
+	destination := func(m map[string]interface{}) *schema.Set {
+		d := map[string]interface{}{
+			"bucket":                     "arn:aws:s3:::destination",
+			"storage_class":              "",
+			"replica_kms_key_id":         "",
+			"account_id":                 "",
+			"access_control_translation": []interface{}{},
+		}
+		for k, v := range m {
+			d[k] = v
+		}
+		return schema.NewSet(destinationHash, []interface{}{d})
+	}
+	sseKmsEnabled := schema.NewSet(sourceSelectionCriteriaHash, []interface{}{
+		map[string]interface{}{
+			"sse_kms_encrypted_objects": schema.NewSet(sourceSseKmsObjectsHash, []interface{}{
+				map[string]interface{}{"enabled": true},
+			}),
+		},
+	})
+	rule := func(m map[string]interface{}) map[string]interface{} {
+		r := map[string]interface{}{
+			"id":                               "rule",
+			"prefix":                           "",
+			"filter":                           []interface{}{},
+			"priority":                         0,
+			"delete_marker_replication_status": "",
+			"status":                           s3.ReplicationRuleStatusEnabled,
+			"destination":                      destination(nil),
+		}
+		for k, v := range m {
+			r[k] = v
+		}
+		return r
+	}
+	filter := []interface{}{map[string]interface{}{"prefix": "foo"}}
+
+	cases := []struct {
+		Name        string
+		Rule        map[string]interface{}
+		ExpectError string
+	}{
+		{
+			Name: "v1 rule",
+			Rule: rule(map[string]interface{}{"prefix": "foo"}),
+		},
+		{
+			Name: "v2 rule",
+			Rule: rule(map[string]interface{}{
+				"filter":                           filter,
+				"priority":                         1,
+				"delete_marker_replication_status": s3.DeleteMarkerReplicationStatusDisabled,
+			}),
+		},
+		{
+			Name:        "priority without filter",
+			Rule:        rule(map[string]interface{}{"priority": 1}),
+			ExpectError: "priority can only be set with filter",
+		},
+		{
+			Name:        "delete marker replication without filter",
+			Rule:        rule(map[string]interface{}{"delete_marker_replication_status": s3.DeleteMarkerReplicationStatusEnabled}),
+			ExpectError: "delete_marker_replication_status can only be set with filter",
+		},
+		{
+			Name:        "prefix with filter",
+			Rule:        rule(map[string]interface{}{"prefix": "foo", "filter": filter}),
+			ExpectError: "prefix conflicts with filter",
+		},
+		{
+			Name:        "sse-kms without replica key",
+			Rule:        rule(map[string]interface{}{"source_selection_criteria": sseKmsEnabled}),
+			ExpectError: "replica_kms_key_id must be set",
+		},
+		{
+			Name: "sse-kms with replica key",
+			Rule: rule(map[string]interface{}{
+				"source_selection_criteria": sseKmsEnabled,
+				"destination":               destination(map[string]interface{}{"replica_kms_key_id": "arn:aws:kms:us-west-2:123456789012:key/replica"}),
+			}),
+		},
+		{
+			Name: "access control translation without account",
+			Rule: rule(map[string]interface{}{
+				"destination": destination(map[string]interface{}{
+					"access_control_translation": []interface{}{map[string]interface{}{"owner": s3.OwnerOverrideDestination}},
+				}),
+			}),
+			ExpectError: "account_id must be set with access_control_translation",
+		},
+		{
+			Name: "access control translation with account",
+			Rule: rule(map[string]interface{}{
+				"destination": destination(map[string]interface{}{
+					"account_id":                 "123456789012",
+					"access_control_translation": []interface{}{map[string]interface{}{"owner": s3.OwnerOverrideDestination}},
+				}),
+			}),
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateS3BucketReplicationRules([]interface{}{tc.Rule})
+		if tc.ExpectError == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", tc.Name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.ExpectError) {
+			t.Errorf("%s: expected error containing %q, got: %v", tc.Name, tc.ExpectError, err)
+		}
+	}
+}
+
+func TestExpandS3ReplicationRuleFilter(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Filter map[string]interface{}
+		Expect *s3.ReplicationRuleFilter
+	}{
+		{
+			Name:   "empty",
+			Filter: nil,
+			Expect: &s3.ReplicationRuleFilter{Prefix: aws.String("")},
+		},
+		{
+			Name:   "prefix",
+			Filter: map[string]interface{}{"prefix": "foo"},
+			Expect: &s3.ReplicationRuleFilter{Prefix: aws.String("foo")},
+		},
+		{
+			Name:   "single tag",
+			Filter: map[string]interface{}{"tags": map[string]interface{}{"Key": "Value"}},
+			Expect: &s3.ReplicationRuleFilter{Tag: &s3.Tag{Key: aws.String("Key"), Value: aws.String("Value")}},
+		},
+		{
+			Name:   "prefix and tag",
+			Filter: map[string]interface{}{"prefix": "foo", "tags": map[string]interface{}{"Key": "Value"}},
+			Expect: &s3.ReplicationRuleFilter{
+				And: &s3.ReplicationRuleAndOperator{
+					Prefix: aws.String("foo"),
+					Tags:   []*s3.Tag{{Key: aws.String("Key"), Value: aws.String("Value")}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		filter := expandS3ReplicationRuleFilter(tc.Filter)
+		if !reflect.DeepEqual(filter, tc.Expect) {
+			t.Errorf("%s: got %s, want %s", tc.Name, filter, tc.Expect)
+		}
+
+		if flattened := flattenS3ReplicationRuleFilter(filter); len(flattened) != 1 {
+			t.Errorf("%s: expected one flattened filter, got %d", tc.Name, len(flattened))
+		} else if expanded := expandS3ReplicationRuleFilter(flattened[0].(map[string]interface{})); !reflect.DeepEqual(expanded, tc.Expect) {
+			t.Errorf("%s: flattened filter expands to %s, want %s", tc.Name, expanded, tc.Expect)
+		}
+	}
+}
+
+func TestFlattenAwsS3BucketReplicationConfiguration_filterTags(t *testing.T) {
+	destination := &s3.Destination{
+		Bucket: aws.String("arn:aws:s3:::destination"),
+	}
+
+	r := &s3.ReplicationConfiguration{
+		Role: aws.String("arn:aws:iam::123456789012:role/replication"),
+		Rules: []*s3.ReplicationRule{
+			{
+				ID:          aws.String("tag"),
+				Destination: destination,
+				Filter: &s3.ReplicationRuleFilter{
+					Tag: &s3.Tag{Key: aws.String("Key1"), Value: aws.String("Value1")},
+				},
+				Priority: aws.Int64(1),
+				Status:   aws.String(s3.ReplicationRuleStatusEnabled),
+			},
+			{
+				ID:          aws.String("and"),
+				Destination: destination,
+				Filter: &s3.ReplicationRuleFilter{
+					And: &s3.ReplicationRuleAndOperator{
+						Prefix: aws.String("foo"),
+						Tags: []*s3.Tag{
+							{Key: aws.String("Key1"), Value: aws.String("Value1")},
+							{Key: aws.String("Key2"), Value: aws.String("Value2")},
+						},
+					},
+				},
+				Priority: aws.Int64(2),
+				Status:   aws.String(s3.ReplicationRuleStatusEnabled),
+			},
+		},
+	}
+
+	flattened := flattenAwsS3BucketReplicationConfiguration(r)
+	if len(flattened) != 1 {
+		t.Fatalf("expected one replication configuration, got %d", len(flattened))
+	}
+
+	rules := flattened[0]["rules"].(*schema.Set).List()
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(rules))
+	}
+
+	expected := map[string]map[string]interface{}{
+		"tag": {
+			"tags": map[string]interface{}{"Key1": "Value1"},
+		},
+		"and": {
+			"prefix": "foo",
+			"tags":   map[string]interface{}{"Key1": "Value1", "Key2": "Value2"},
+		},
+	}
+	for _, v := range rules {
+		rule := v.(map[string]interface{})
+		id := rule["id"].(string)
+		filter := rule["filter"].([]interface{})[0].(map[string]interface{})
+		if !reflect.DeepEqual(filter, expected[id]) {
+			t.Errorf("rule %q: got filter %#v, want %#v", id, filter, expected[id])
+		}
+	}
+}
+
 func TestAWSS3BucketName(t *testing.T) {
 	validDnsNames := []string{
 		"foobar",
@@ -1096,6 +1448,63 @@ func TestAWSS3BucketName(t *testing.T) {
 	}
 }
 
+func TestResourceAwsS3BucketCustomizeDiff_externallyManaged(t *testing.T) {
+	cases := []struct {
+		Config map[string]interface{}
+		Error  string
+	}{
+		{
+			Config: map[string]interface{}{
+				"bucket":             "tf-test-bucket",
+				"externally_managed": []interface{}{"cors_rule"},
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"bucket":             "tf-test-bucket",
+				"externally_managed": []interface{}{"cors_rule"},
+				"cors_rule": []interface{}{
+					map[string]interface{}{
+						"allowed_methods": []interface{}{"GET"},
+						"allowed_origins": []interface{}{"*"},
+					},
+				},
+			},
+			Error: `"cors_rule" is externally managed`,
+		},
+		{
+			Config: map[string]interface{}{
+				"bucket":             "tf-test-bucket",
+				"externally_managed": []interface{}{"versioning"},
+				"versioning": []interface{}{
+					map[string]interface{}{
+						"enabled": true,
+					},
+				},
+			},
+			Error: `"versioning" is externally managed`,
+		},
+	}
+
+	for i, tc := range cases {
+		raw, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+
+		_, err = resourceAwsS3Bucket().Diff(nil, terraform.NewResourceConfig(raw), nil)
+		if tc.Error == "" {
+			if err != nil {
+				t.Fatalf("%d: unexpected error: %s", i, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.Error) {
+			t.Fatalf("%d: expected error containing %q, got: %v", i, tc.Error, err)
+		}
+	}
+}
+
 func TestBucketRegionalDomainName(t *testing.T) {
 	const bucket = "bucket-name"
 
@@ -2118,6 +2527,22 @@ resource "aws_s3_bucket" "destination" {
 `, randInt, randInt, randInt)
 }
 
+func testAccAWSS3BucketConfigReplicationWithoutVersioning(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_s3_bucket" "bucket" {
+    provider = "aws.uswest2"
+    bucket   = "tf-test-bucket-%d"
+    acl      = "private"
+}
+
+resource "aws_s3_bucket" "destination" {
+    provider = "aws.euwest"
+    bucket   = "tf-test-bucket-destination-%d"
+    region   = "eu-west-1"
+}
+`, randInt, randInt, randInt)
+}
+
 func testAccAWSS3BucketConfigReplicationWithConfiguration(randInt int) string {
 	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
 resource "aws_s3_bucket" "bucket" {
@@ -2278,6 +2703,146 @@ resource "aws_s3_bucket" "destination" {
 `, randInt, randInt, randInt)
 }
 
+func testAccAWSS3BucketConfigReplicationWithV2Filter(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_s3_bucket" "bucket" {
+  provider = "aws.uswest2"
+  bucket   = "tf-test-bucket-%d"
+  acl      = "private"
+
+  versioning {
+    enabled = true
+  }
+
+  replication_configuration {
+    role = "${aws_iam_role.role.arn}"
+
+    rules {
+      id       = "foobar"
+      priority = 10
+      status   = "Enabled"
+
+      filter {
+        prefix = "foo"
+
+        tags {
+          ReplicateMe = "Yes"
+        }
+      }
+
+      delete_marker_replication_status = "Disabled"
+
+      destination {
+        bucket        = "${aws_s3_bucket.destination.arn}"
+        storage_class = "STANDARD"
+      }
+    }
+  }
+}
+
+resource "aws_s3_bucket" "destination" {
+  provider = "aws.euwest"
+  bucket   = "tf-test-bucket-destination-%d"
+  region   = "eu-west-1"
+
+  versioning {
+    enabled = true
+  }
+}
+`, randInt, randInt, randInt)
+}
+
+func testAccAWSS3BucketConfigReplicationWithAccessControlTranslation(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "bucket" {
+  provider = "aws.uswest2"
+  bucket   = "tf-test-bucket-%d"
+  acl      = "private"
+
+  versioning {
+    enabled = true
+  }
+
+  replication_configuration {
+    role = "${aws_iam_role.role.arn}"
+
+    rules {
+      id     = "foobar"
+      status = "Enabled"
+
+      filter {}
+
+      destination {
+        bucket        = "${aws_s3_bucket.destination.arn}"
+        storage_class = "STANDARD"
+        account_id    = "${data.aws_caller_identity.current.account_id}"
+
+        access_control_translation {
+          owner = "Destination"
+        }
+      }
+    }
+  }
+}
+
+resource "aws_s3_bucket" "destination" {
+  provider = "aws.euwest"
+  bucket   = "tf-test-bucket-destination-%d"
+  region   = "eu-west-1"
+
+  versioning {
+    enabled = true
+  }
+}
+`, randInt, randInt, randInt)
+}
+
+func testAccAWSS3BucketConfigReplicationSseKmsNoReplicaKey(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_s3_bucket" "bucket" {
+  provider = "aws.uswest2"
+  bucket   = "tf-test-bucket-%d"
+  acl      = "private"
+
+  versioning {
+    enabled = true
+  }
+
+  replication_configuration {
+    role = "${aws_iam_role.role.arn}"
+
+    rules {
+      id     = "foobar"
+      prefix = "foo"
+      status = "Enabled"
+
+      destination {
+        bucket = "${aws_s3_bucket.destination.arn}"
+      }
+
+      source_selection_criteria {
+        sse_kms_encrypted_objects {
+          enabled = true
+        }
+      }
+    }
+  }
+}
+
+resource "aws_s3_bucket" "destination" {
+  provider = "aws.euwest"
+  bucket   = "tf-test-bucket-destination-%d"
+  region   = "eu-west-1"
+
+  versioning {
+    enabled = true
+  }
+}
+`, randInt, randInt, randInt)
+}
+
 const testAccAWSS3BucketConfig_namePrefix = `
 resource "aws_s3_bucket" "test" {
 	bucket_prefix = "tf-test-"
@@ -0,0 +1,620 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// s3ObjectCopyPartSize is the default size of each part copied with
+// UploadPartCopy when an object is too large for a single CopyObject.
+const s3ObjectCopyPartSize = 512 * 1024 * 1024
+
+func resourceAwsS3ObjectCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3ObjectCopyPut,
+		Read:   resourceAwsS3ObjectCopyRead,
+		Update: resourceAwsS3ObjectCopyUpdate,
+		Delete: resourceAwsS3ObjectCopyDelete,
+
+		CustomizeDiff: resourceAwsS3ObjectCopyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[^/]+/.+$`), "must be in the form bucket/key"),
+			},
+
+			"source_version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"source_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"metadata_directive": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  s3.MetadataDirectiveCopy,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.MetadataDirectiveCopy,
+					s3.MetadataDirectiveReplace,
+				}, false),
+			},
+
+			"tagging_directive": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  s3.TaggingDirectiveCopy,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.TaggingDirectiveCopy,
+					s3.TaggingDirectiveReplace,
+				}, false),
+			},
+
+			"acl": {
+				Type:     schema.TypeString,
+				Default:  "private",
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectCannedACLPrivate,
+					s3.ObjectCannedACLPublicRead,
+					s3.ObjectCannedACLPublicReadWrite,
+					s3.ObjectCannedACLAuthenticatedRead,
+					s3.ObjectCannedACLAwsExecRead,
+					s3.ObjectCannedACLBucketOwnerRead,
+					s3.ObjectCannedACLBucketOwnerFullControl,
+				}, false),
+			},
+
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateS3BucketObjectMetadata,
+			},
+
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_encoding": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_language": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"website_redirect": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.StorageClassStandard,
+					s3.StorageClassReducedRedundancy,
+					s3.StorageClassOnezoneIa,
+					s3.StorageClassStandardIa,
+				}, false),
+			},
+
+			"server_side_encryption": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ServerSideEncryptionAes256,
+					s3.ServerSideEncryptionAwsKms,
+				}, false),
+			},
+
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"source_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3ObjectCopyCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("metadata_directive").(string) == s3.MetadataDirectiveCopy {
+		for _, k := range []string{"cache_control", "content_disposition", "content_encoding", "content_language", "content_type", "website_redirect"} {
+			if diff.Get(k).(string) != "" {
+				return fmt.Errorf("%q can only be set when metadata_directive is %q", k, s3.MetadataDirectiveReplace)
+			}
+		}
+		if len(diff.Get("metadata").(map[string]interface{})) > 0 {
+			return fmt.Errorf("%q can only be set when metadata_directive is %q", "metadata", s3.MetadataDirectiveReplace)
+		}
+	}
+	if diff.Get("tagging_directive").(string) == s3.TaggingDirectiveCopy && len(diff.Get("tags").(map[string]interface{})) > 0 {
+		return fmt.Errorf("%q can only be set when tagging_directive is %q", "tags", s3.TaggingDirectiveReplace)
+	}
+
+	// The object is copied again whenever the source content changes.
+	for _, k := range []string{"source", "source_version_id", "source_region"} {
+		if !diff.NewValueKnown(k) {
+			return diff.SetNewComputed("source_etag")
+		}
+	}
+
+	conn := s3ConnForRegion(meta.(*AWSClient).s3conn, diff.Get("source_region").(string))
+	head, err := s3ObjectCopyHeadSource(conn, diff.Get("source").(string), diff.Get("source_version_id").(string))
+	if awsErr, ok := err.(awserr.RequestFailure); ok && awsErr.StatusCode() == 404 {
+		// The source may be created later on in the same apply.
+		log.Printf("[DEBUG] S3 object copy source (%s) not found", diff.Get("source").(string))
+		return diff.SetNewComputed("source_etag")
+	}
+	if err != nil {
+		return fmt.Errorf("error reading S3 object copy source (%s): %s", diff.Get("source").(string), err)
+	}
+
+	if etag := strings.Trim(aws.StringValue(head.ETag), `"`); etag != diff.Get("source_etag").(string) {
+		return diff.SetNew("source_etag", etag)
+	}
+
+	return nil
+}
+
+func resourceAwsS3ObjectCopyPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	source := d.Get("source").(string)
+	sourceVersionID := d.Get("source_version_id").(string)
+
+	sourceConn := s3ConnForRegion(s3conn, d.Get("source_region").(string))
+	head, err := s3ObjectCopyHeadSource(sourceConn, source, sourceVersionID)
+	if err != nil {
+		return fmt.Errorf("error reading S3 object copy source (%s): %s", source, err)
+	}
+
+	copySource := url.PathEscape(source)
+	if sourceVersionID != "" {
+		copySource += "?versionId=" + url.QueryEscape(sourceVersionID)
+	}
+
+	input := &s3.CopyObjectInput{
+		Bucket:            aws.String(bucket),
+		Key:               aws.String(key),
+		CopySource:        aws.String(copySource),
+		CopySourceIfMatch: head.ETag,
+		ACL:               aws.String(d.Get("acl").(string)),
+		MetadataDirective: aws.String(d.Get("metadata_directive").(string)),
+		TaggingDirective:  aws.String(d.Get("tagging_directive").(string)),
+	}
+
+	if d.Get("metadata_directive").(string) == s3.MetadataDirectiveReplace {
+		if v, ok := d.GetOk("metadata"); ok {
+			input.Metadata = stringMapToPointers(v.(map[string]interface{}))
+		}
+		if v, ok := d.GetOk("cache_control"); ok {
+			input.CacheControl = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("content_disposition"); ok {
+			input.ContentDisposition = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("content_encoding"); ok {
+			input.ContentEncoding = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("content_language"); ok {
+			input.ContentLanguage = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("content_type"); ok {
+			input.ContentType = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("website_redirect"); ok {
+			input.WebsiteRedirectLocation = aws.String(v.(string))
+		}
+	}
+
+	if d.Get("tagging_directive").(string) == s3.TaggingDirectiveReplace {
+		if meta.(*AWSClient).IsChinaCloud() {
+			return fmt.Errorf("This region does not allow for tags on S3 objects")
+		}
+
+		// The tag-set must be encoded as URL Query parameters.
+		values := url.Values{}
+		for k, v := range d.Get("tags").(map[string]interface{}) {
+			values.Add(k, v.(string))
+		}
+		input.Tagging = aws.String(values.Encode())
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	if aws.Int64Value(head.ContentLength) <= s3ObjectMaxCopySize {
+		log.Printf("[DEBUG] Copying S3 object (%s) to S3 Bucket (%s) Object (%s)", source, bucket, key)
+		_, err = s3conn.CopyObject(input)
+	} else {
+		err = s3ObjectCopyMultipart(s3conn, sourceConn, source, input, head)
+	}
+	if err != nil {
+		return fmt.Errorf("error copying S3 object (%s) to S3 Bucket (%s) Object (%s): %s", source, bucket, key, err)
+	}
+
+	d.Set("source_etag", strings.Trim(aws.StringValue(head.ETag), `"`))
+	d.SetId(key)
+
+	return resourceAwsS3ObjectCopyRead(d, meta)
+}
+
+func resourceAwsS3ObjectCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	// The source content, metadata and encryption can only be changed by
+	// copying the object again, which also applies every other argument.
+	for _, k := range []string{"source", "source_version_id", "source_region", "source_etag", "metadata_directive",
+		"tagging_directive", "metadata", "cache_control", "content_disposition", "content_encoding", "content_language",
+		"content_type", "website_redirect", "storage_class", "server_side_encryption", "kms_key_id"} {
+		if d.HasChange(k) {
+			return resourceAwsS3ObjectCopyPut(d, meta)
+		}
+	}
+
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	if d.HasChange("acl") {
+		input := &s3.PutObjectAclInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			ACL:    aws.String(d.Get("acl").(string)),
+		}
+
+		log.Printf("[DEBUG] Putting S3 Bucket (%s) Object (%s) ACL: %s", bucket, key, input)
+		if _, err := s3conn.PutObjectAcl(input); err != nil {
+			return fmt.Errorf("error putting S3 Bucket (%s) Object (%s) ACL: %s", bucket, key, err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if meta.(*AWSClient).IsChinaCloud() {
+			return fmt.Errorf("This region does not allow for tags on S3 objects")
+		}
+
+		if err := setTagsS3BucketObject(s3conn, d); err != nil {
+			return fmt.Errorf("error updating S3 Bucket (%s) Object (%s) tags: %s", bucket, key, err)
+		}
+	}
+
+	return resourceAwsS3ObjectCopyRead(d, meta)
+}
+
+func resourceAwsS3ObjectCopyRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	resp, err := s3conn.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if awsErr, ok := err.(awserr.RequestFailure); ok && awsErr.StatusCode() == 404 {
+		log.Printf("[WARN] S3 Bucket (%s) Object (%s) not found, removing from state", bucket, key)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+	}
+
+	d.Set("etag", strings.Trim(aws.StringValue(resp.ETag), `"`))
+	d.Set("version_id", resp.VersionId)
+	d.Set("server_side_encryption", resp.ServerSideEncryption)
+
+	// The "STANDARD" (which is also the default) storage
+	// class when set would not be included in the results.
+	d.Set("storage_class", s3.StorageClassStandard)
+	if resp.StorageClass != nil {
+		d.Set("storage_class", resp.StorageClass)
+	}
+
+	// With the COPY directives these values come from the source object.
+	if d.Get("metadata_directive").(string) == s3.MetadataDirectiveReplace {
+		d.Set("cache_control", resp.CacheControl)
+		d.Set("content_disposition", resp.ContentDisposition)
+		d.Set("content_encoding", resp.ContentEncoding)
+		d.Set("content_language", resp.ContentLanguage)
+		d.Set("content_type", resp.ContentType)
+		d.Set("website_redirect", resp.WebsiteRedirectLocation)
+
+		metadata := make(map[string]interface{}, len(resp.Metadata))
+		for k, v := range resp.Metadata {
+			metadata[strings.ToLower(k)] = aws.StringValue(v)
+		}
+		if err := d.Set("metadata", metadata); err != nil {
+			return fmt.Errorf("error setting metadata: %s", err)
+		}
+	}
+
+	if d.Get("tagging_directive").(string) == s3.TaggingDirectiveReplace && !meta.(*AWSClient).IsChinaCloud() {
+		tagResp, err := s3conn.GetObjectTagging(&s3.GetObjectTaggingInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("error reading S3 Bucket (%s) Object (%s) tags: %s", bucket, key, err)
+		}
+		if err := d.Set("tags", tagsToMapS3(tagResp.TagSet)); err != nil {
+			return fmt.Errorf("error setting tags: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsS3ObjectCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	log.Printf("[DEBUG] Deleting S3 Bucket (%s) Object (%s)", bucket, key)
+	_, err := s3conn.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+	}
+
+	return nil
+}
+
+// s3ConnForRegion returns a S3 client using the provider credentials in region.
+func s3ConnForRegion(conn *s3.S3, region string) *s3.S3 {
+	if region == "" || region == aws.StringValue(conn.Config.Region) {
+		return conn
+	}
+
+	return s3.New(session.New(conn.Config.Copy(&aws.Config{Region: aws.String(region)})))
+}
+
+func s3ObjectCopyHeadSource(conn *s3.S3, source, versionID string) (*s3.HeadObjectOutput, error) {
+	bucket, key := s3ObjectCopyParseSource(source)
+
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	return conn.HeadObject(input)
+}
+
+// s3ObjectCopyParseSource splits a "bucket/key" copy source.
+func s3ObjectCopyParseSource(source string) (string, string) {
+	parts := strings.SplitN(source, "/", 2)
+	if len(parts) != 2 {
+		return source, ""
+	}
+	return parts[0], parts[1]
+}
+
+// s3ObjectCopyMultipart copies objects larger than CopyObject allows using
+// UploadPartCopy. The metadata and tags of the source have to be copied
+// explicitly as a multipart upload starts out without them.
+func s3ObjectCopyMultipart(conn, sourceConn *s3.S3, source string, input *s3.CopyObjectInput, head *s3.HeadObjectOutput) error {
+	create := &s3.CreateMultipartUploadInput{
+		Bucket:                  input.Bucket,
+		Key:                     input.Key,
+		ACL:                     input.ACL,
+		CacheControl:            input.CacheControl,
+		ContentDisposition:      input.ContentDisposition,
+		ContentEncoding:         input.ContentEncoding,
+		ContentLanguage:         input.ContentLanguage,
+		ContentType:             input.ContentType,
+		Metadata:                input.Metadata,
+		SSEKMSKeyId:             input.SSEKMSKeyId,
+		ServerSideEncryption:    input.ServerSideEncryption,
+		StorageClass:            input.StorageClass,
+		Tagging:                 input.Tagging,
+		WebsiteRedirectLocation: input.WebsiteRedirectLocation,
+	}
+
+	if aws.StringValue(input.MetadataDirective) == s3.MetadataDirectiveCopy {
+		create.CacheControl = head.CacheControl
+		create.ContentDisposition = head.ContentDisposition
+		create.ContentEncoding = head.ContentEncoding
+		create.ContentLanguage = head.ContentLanguage
+		create.ContentType = head.ContentType
+		create.Metadata = head.Metadata
+		create.WebsiteRedirectLocation = head.WebsiteRedirectLocation
+	}
+
+	if aws.StringValue(input.TaggingDirective) == s3.TaggingDirectiveCopy {
+		sourceBucket, sourceKey := s3ObjectCopyParseSource(source)
+
+		tagInput := &s3.GetObjectTaggingInput{
+			Bucket: aws.String(sourceBucket),
+			Key:    aws.String(sourceKey),
+		}
+		if head.VersionId != nil {
+			tagInput.VersionId = head.VersionId
+		}
+		tagResp, err := sourceConn.GetObjectTagging(tagInput)
+		if err != nil {
+			return fmt.Errorf("error reading source tags: %s", err)
+		}
+
+		values := url.Values{}
+		for _, t := range tagResp.TagSet {
+			values.Add(aws.StringValue(t.Key), aws.StringValue(t.Value))
+		}
+		create.Tagging = aws.String(values.Encode())
+	}
+
+	upload, err := conn.CreateMultipartUpload(create)
+	if err != nil {
+		return fmt.Errorf("error creating multipart upload: %s", err)
+	}
+
+	size := aws.Int64Value(head.ContentLength)
+	partSize := int64(s3ObjectCopyPartSize)
+	if size/partSize >= int64(s3manager.MaxUploadParts) {
+		partSize = (size / int64(s3manager.MaxUploadParts)) + 1
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		parts []*s3.CompletedPart
+		errs  *multierror.Error
+	)
+
+	queue := make(chan int64)
+	for i := 0; i < s3manager.DefaultUploadConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for number := range queue {
+				start := (number - 1) * partSize
+				end := start + partSize - 1
+				if end >= size {
+					end = size - 1
+				}
+
+				log.Printf("[DEBUG] Copying part %d (bytes %d-%d) of %s", number, start, end, aws.StringValue(input.CopySource))
+				resp, err := conn.UploadPartCopy(&s3.UploadPartCopyInput{
+					Bucket:            upload.Bucket,
+					Key:               upload.Key,
+					UploadId:          upload.UploadId,
+					PartNumber:        aws.Int64(number),
+					CopySource:        input.CopySource,
+					CopySourceIfMatch: head.ETag,
+					CopySourceRange:   aws.String(fmt.Sprintf("bytes=%d-%d", start, end)),
+				})
+
+				mu.Lock()
+				if err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("error copying part %d: %s", number, err))
+				} else {
+					parts = append(parts, &s3.CompletedPart{
+						ETag:       resp.CopyPartResult.ETag,
+						PartNumber: aws.Int64(number),
+					})
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for number := int64(1); (number-1)*partSize < size; number++ {
+		queue <- number
+	}
+	close(queue)
+	wg.Wait()
+
+	if err := errs.ErrorOrNil(); err != nil {
+		_, abortErr := conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+			Bucket:   upload.Bucket,
+			Key:      upload.Key,
+			UploadId: upload.UploadId,
+		})
+		if abortErr != nil {
+			log.Printf("[WARN] Error aborting multipart upload (%s): %s", aws.StringValue(upload.UploadId), abortErr)
+		}
+		return err
+	}
+
+	sort.Slice(parts, func(i, j int) bool {
+		return aws.Int64Value(parts[i].PartNumber) < aws.Int64Value(parts[j].PartNumber)
+	})
+
+	_, err = conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:          upload.Bucket,
+		Key:             upload.Key,
+		UploadId:        upload.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		return fmt.Errorf("error completing multipart upload: %s", err)
+	}
+
+	return nil
+}
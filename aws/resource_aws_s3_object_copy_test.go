@@ -0,0 +1,303 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestS3ObjectCopyParseSource(t *testing.T) {
+	cases := []struct {
+		Source string
+		Bucket string
+		Key    string
+	}{
+		{"bucket/key", "bucket", "key"},
+		{"bucket/path/to/key.zip", "bucket", "path/to/key.zip"},
+		{"bucket", "bucket", ""},
+	}
+
+	for _, tc := range cases {
+		bucket, key := s3ObjectCopyParseSource(tc.Source)
+		if bucket != tc.Bucket || key != tc.Key {
+			t.Errorf("%s: got (%s, %s), want (%s, %s)", tc.Source, bucket, key, tc.Bucket, tc.Key)
+		}
+	}
+}
+
+func TestAccAWSS3ObjectCopy_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_object_copy.copy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ObjectCopyConfig_basic(rInt, "initial content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "source_etag", "aws_s3_bucket_object.source", "etag"),
+					resource.TestCheckResourceAttrPair(resourceName, "etag", "aws_s3_bucket_object.source", "etag"),
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+				),
+			},
+			{
+				Config: testAccAWSS3ObjectCopyConfig_basic(rInt, "modified content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "source_etag", "aws_s3_bucket_object.source", "etag"),
+					resource.TestCheckResourceAttrPair(resourceName, "etag", "aws_s3_bucket_object.source", "etag"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ObjectCopy_replaceMetadata(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_object_copy.copy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ObjectCopyConfig_replaceMetadata(rInt, "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/zip"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.stage", "prod"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Stage", "prod"),
+				),
+			},
+			{
+				Config: testAccAWSS3ObjectCopyConfig_replaceMetadata(rInt, "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.stage", "staging"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Stage", "staging"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ObjectCopy_aclAndTags(t *testing.T) {
+	var versionID string
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_object_copy.copy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ObjectCopyConfig_aclAndTags(rInt, s3.ObjectCannedACLPrivate, "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName),
+					testAccCheckAWSS3ObjectCopyVersionID(resourceName, &versionID),
+					resource.TestCheckResourceAttr(resourceName, "acl", s3.ObjectCannedACLPrivate),
+					resource.TestCheckResourceAttr(resourceName, "tags.Stage", "prod"),
+				),
+			},
+			{
+				// The object is updated in place rather than copied again.
+				Config: testAccAWSS3ObjectCopyConfig_aclAndTags(rInt, s3.ObjectCannedACLPublicRead, "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttrPtr(resourceName, "version_id", &versionID),
+					resource.TestCheckResourceAttr(resourceName, "acl", s3.ObjectCannedACLPublicRead),
+					resource.TestCheckResourceAttr(resourceName, "tags.Stage", "staging"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3ObjectCopyVersionID(n string, versionID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		*versionID = rs.Primary.Attributes["version_id"]
+		return nil
+	}
+}
+
+func TestAccAWSS3ObjectCopy_kms(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_object_copy.copy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ObjectCopyConfig_kms(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption", "aws:kms"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3ObjectCopyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Object Copy ID is set")
+		}
+
+		s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+		_, err := s3conn.HeadObject(&s3.HeadObjectInput{
+			Bucket:  aws.String(rs.Primary.Attributes["bucket"]),
+			Key:     aws.String(rs.Primary.Attributes["key"]),
+			IfMatch: aws.String(rs.Primary.Attributes["etag"]),
+		})
+		if err != nil {
+			return fmt.Errorf("S3 Object Copy error: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3ObjectCopyDestroy(s *terraform.State) error {
+	s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_object_copy" {
+			continue
+		}
+
+		_, err := s3conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(rs.Primary.Attributes["key"]),
+		})
+		if err == nil {
+			return fmt.Errorf("S3 Object Copy still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSS3ObjectCopyConfigBuckets(randInt int, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = "tf-object-copy-source-%[1]d"
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket" "target" {
+  bucket = "tf-object-copy-target-%[1]d"
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket_object" "source" {
+  bucket  = "${aws_s3_bucket.source.bucket}"
+  key     = "artifacts/app.zip"
+  content = %[2]q
+
+  tags {
+    Stage = "dev"
+  }
+}
+`, randInt, content)
+}
+
+func testAccAWSS3ObjectCopyConfig_basic(randInt int, content string) string {
+	return testAccAWSS3ObjectCopyConfigBuckets(randInt, content) + `
+resource "aws_s3_object_copy" "copy" {
+  bucket = "${aws_s3_bucket.target.bucket}"
+  key    = "releases/app.zip"
+  source = "${aws_s3_bucket.source.bucket}/${aws_s3_bucket_object.source.key}"
+
+  # Copy again whenever a new version of the source is uploaded.
+  source_version_id = "${aws_s3_bucket_object.source.version_id}"
+}
+`
+}
+
+func testAccAWSS3ObjectCopyConfig_replaceMetadata(randInt int, stage string) string {
+	return testAccAWSS3ObjectCopyConfigBuckets(randInt, "content") + fmt.Sprintf(`
+resource "aws_s3_object_copy" "copy" {
+  bucket = "${aws_s3_bucket.target.bucket}"
+  key    = "releases/app.zip"
+  source = "${aws_s3_bucket.source.bucket}/${aws_s3_bucket_object.source.key}"
+
+  metadata_directive = "REPLACE"
+  content_type       = "application/zip"
+
+  metadata {
+    stage = %[1]q
+  }
+
+  tagging_directive = "REPLACE"
+
+  tags {
+    Stage = %[1]q
+  }
+}
+`, stage)
+}
+
+func testAccAWSS3ObjectCopyConfig_aclAndTags(randInt int, acl, stage string) string {
+	return testAccAWSS3ObjectCopyConfigBuckets(randInt, "content") + fmt.Sprintf(`
+resource "aws_s3_object_copy" "copy" {
+  bucket = "${aws_s3_bucket.target.bucket}"
+  key    = "releases/app.zip"
+  source = "${aws_s3_bucket.source.bucket}/${aws_s3_bucket_object.source.key}"
+  acl    = %[1]q
+
+  tagging_directive = "REPLACE"
+
+  tags {
+    Stage = %[2]q
+  }
+}
+`, acl, stage)
+}
+
+func testAccAWSS3ObjectCopyConfig_kms(randInt int) string {
+	return testAccAWSS3ObjectCopyConfigBuckets(randInt, "content") + `
+resource "aws_kms_key" "test" {
+  description             = "Terraform acc test S3 object copy"
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_object_copy" "copy" {
+  bucket     = "${aws_s3_bucket.target.bucket}"
+  key        = "releases/app.zip"
+  source     = "${aws_s3_bucket.source.bucket}/${aws_s3_bucket_object.source.key}"
+  kms_key_id = "${aws_kms_key.test.arn}"
+}
+`
+}
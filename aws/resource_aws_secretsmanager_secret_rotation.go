@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSecretsManagerSecretRotation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecretsManagerSecretRotationCreate,
+		Read:   resourceAwsSecretsManagerSecretRotationRead,
+		Update: resourceAwsSecretsManagerSecretRotationUpdate,
+		Delete: resourceAwsSecretsManagerSecretRotationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rotation_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rotation_lambda_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"rotation_rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automatically_after_days": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsSecretsManagerSecretRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).secretsmanagerconn
+	secretID := d.Get("secret_id").(string)
+
+	if err := rotateSecretsManagerSecret(conn, secretID, d); err != nil {
+		return fmt.Errorf("error enabling Secrets Manager Secret %q rotation: %s", secretID, err)
+	}
+
+	d.SetId(secretID)
+
+	return resourceAwsSecretsManagerSecretRotationRead(d, meta)
+}
+
+func resourceAwsSecretsManagerSecretRotationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).secretsmanagerconn
+
+	input := &secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Secrets Manager Secret: %s", input)
+	output, err := conn.DescribeSecret(input)
+	if err != nil {
+		if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Secrets Manager Secret %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Secrets Manager Secret: %s", err)
+	}
+
+	if output.DeletedDate != nil {
+		log.Printf("[WARN] Secrets Manager Secret %q is scheduled for deletion - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("secret_id", d.Id())
+	d.Set("rotation_enabled", output.RotationEnabled)
+
+	if aws.BoolValue(output.RotationEnabled) {
+		d.Set("rotation_lambda_arn", output.RotationLambdaARN)
+		if err := d.Set("rotation_rules", flattenSecretsManagerRotationRules(output.RotationRules)); err != nil {
+			return fmt.Errorf("error setting rotation_rules: %s", err)
+		}
+	} else {
+		d.Set("rotation_lambda_arn", "")
+		d.Set("rotation_rules", []interface{}{})
+	}
+
+	return nil
+}
+
+func resourceAwsSecretsManagerSecretRotationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).secretsmanagerconn
+
+	if d.HasChange("rotation_lambda_arn") || d.HasChange("rotation_rules") {
+		if err := rotateSecretsManagerSecret(conn, d.Id(), d); err != nil {
+			return fmt.Errorf("error updating Secrets Manager Secret %q rotation: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSecretsManagerSecretRotationRead(d, meta)
+}
+
+func resourceAwsSecretsManagerSecretRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).secretsmanagerconn
+
+	input := &secretsmanager.CancelRotateSecretInput{
+		SecretId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Cancelling Secrets Manager Secret rotation: %s", input)
+	_, err := conn.CancelRotateSecret(input)
+	if err != nil {
+		if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error cancelling Secret Manager Secret %q rotation: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func rotateSecretsManagerSecret(conn *secretsmanager.SecretsManager, secretID string, d *schema.ResourceData) error {
+	input := &secretsmanager.RotateSecretInput{
+		RotationLambdaARN: aws.String(d.Get("rotation_lambda_arn").(string)),
+		RotationRules:     expandSecretsManagerRotationRules(d.Get("rotation_rules").([]interface{})),
+		SecretId:          aws.String(secretID),
+	}
+
+	log.Printf("[DEBUG] Enabling Secrets Manager Secret rotation: %s", input)
+	return resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.RotateSecret(input)
+		if err != nil {
+			// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
+			if isAWSErr(err, "AccessDeniedException", "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
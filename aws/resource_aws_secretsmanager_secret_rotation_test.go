@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsSecretsManagerSecretRotation_Basic(t *testing.T) {
+	var secret secretsmanager.DescribeSecretOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_secretsmanager_secret_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecretsManagerSecretRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecretsManagerSecretRotationConfig(rName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExists("aws_secretsmanager_secret.test", &secret),
+					resource.TestCheckResourceAttrPair(resourceName, "secret_id", "aws_secretsmanager_secret.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestMatchResourceAttr(resourceName, "rotation_lambda_arn", regexp.MustCompile(fmt.Sprintf("^arn:[^:]+:lambda:[^:]+:[^:]+:function:%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.automatically_after_days", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSecretsManagerSecretRotationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_secretsmanager_secret_rotation" {
+			continue
+		}
+
+		output, err := conn.DescribeSecret(&secretsmanager.DescribeSecretInput{
+			SecretId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.DeletedDate == nil && aws.BoolValue(output.RotationEnabled) {
+			return fmt.Errorf("Secrets Manager Secret %q rotation still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsSecretsManagerSecretRotationConfig(rName string, automaticallyAfterDays int) string {
+	return baseAccAWSLambdaConfig(rName, rName, rName) + fmt.Sprintf(`
+# Not a real rotation function
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%[1]s"
+  handler       = "exports.example"
+  role          = "${aws_iam_role.iam_for_lambda.arn}"
+  runtime       = "nodejs4.3"
+}
+
+resource "aws_lambda_permission" "test" {
+  action         = "lambda:InvokeFunction"
+  function_name  = "${aws_lambda_function.test.function_name}"
+  principal      = "secretsmanager.amazonaws.com"
+  statement_id   = "AllowExecutionFromSecretsManager1"
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = "%[1]s"
+}
+
+resource "aws_secretsmanager_secret_rotation" "test" {
+  secret_id           = "${aws_secretsmanager_secret.test.id}"
+  rotation_lambda_arn = "${aws_lambda_function.test.arn}"
+
+  rotation_rules {
+    automatically_after_days = %[2]d
+  }
+
+  depends_on = ["aws_lambda_permission.test"]
+}
+`, rName, automaticallyAfterDays)
+}
@@ -245,13 +245,14 @@ func TestAccAwsSecretsManagerSecret_RotationLambdaARN(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"recovery_window_in_days"},
 			},
-			// Test removing rotation on resource update
+			// Test removing rotation from configuration leaves it to be
+			// managed by aws_secretsmanager_secret_rotation
 			{
-				Config: testAccAwsSecretsManagerSecretConfig_Name(rName),
+				Config: testAccAwsSecretsManagerSecretConfig_RotationLambdaARN_Removed(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsSecretsManagerSecretExists(resourceName, &secret),
-					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "false"),
-					resource.TestCheckResourceAttr(resourceName, "rotation_lambda_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestMatchResourceAttr(resourceName, "rotation_lambda_arn", regexp.MustCompile(fmt.Sprintf("^arn:[^:]+:lambda:[^:]+:[^:]+:function:%s-1$", rName))),
 				),
 			},
 		},
@@ -299,13 +300,15 @@ func TestAccAwsSecretsManagerSecret_RotationRules(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"recovery_window_in_days"},
 			},
-			// Test removing rotation rules on resource update
+			// Test removing rotation rules from configuration leaves them
+			// to be managed by aws_secretsmanager_secret_rotation
 			{
-				Config: testAccAwsSecretsManagerSecretConfig_Name(rName),
+				Config: testAccAwsSecretsManagerSecretConfig_RotationRules_Removed(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsSecretsManagerSecretExists(resourceName, &secret),
-					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "false"),
-					resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.automatically_after_days", "7"),
 				),
 			},
 		},
@@ -655,6 +658,54 @@ resource "aws_secretsmanager_secret" "test" {
 `, rName, automaticallyAfterDays)
 }
 
+func testAccAwsSecretsManagerSecretConfig_RotationLambdaARN_Removed(rName string) string {
+	return baseAccAWSLambdaConfig(rName, rName, rName) + fmt.Sprintf(`
+# Not a real rotation function
+resource "aws_lambda_function" "test1" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%[1]s-1"
+  handler       = "exports.example"
+  role          = "${aws_iam_role.iam_for_lambda.arn}"
+  runtime       = "nodejs4.3"
+}
+
+resource "aws_lambda_permission" "test1" {
+  action         = "lambda:InvokeFunction"
+  function_name  = "${aws_lambda_function.test1.function_name}"
+  principal      = "secretsmanager.amazonaws.com"
+  statement_id   = "AllowExecutionFromSecretsManager1"
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = "%[1]s"
+}
+`, rName)
+}
+
+func testAccAwsSecretsManagerSecretConfig_RotationRules_Removed(rName string) string {
+	return baseAccAWSLambdaConfig(rName, rName, rName) + fmt.Sprintf(`
+# Not a real rotation function
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%[1]s"
+  handler       = "exports.example"
+  role          = "${aws_iam_role.iam_for_lambda.arn}"
+  runtime       = "nodejs4.3"
+}
+
+resource "aws_lambda_permission" "test" {
+  action         = "lambda:InvokeFunction"
+  function_name  = "${aws_lambda_function.test.function_name}"
+  principal      = "secretsmanager.amazonaws.com"
+  statement_id   = "AllowExecutionFromSecretsManager1"
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = "%[1]s"
+}
+`, rName)
+}
+
 func testAccAwsSecretsManagerSecretConfig_Tags_Single(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_secretsmanager_secret" "test" {
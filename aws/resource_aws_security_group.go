@@ -29,6 +29,8 @@ func resourceAwsSecurityGroup() *schema.Resource {
 			State: resourceAwsSecurityGroupImportState,
 		},
 
+		CustomizeDiff: resourceAwsSecurityGroupCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -225,6 +227,12 @@ func resourceAwsSecurityGroup() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -367,9 +375,12 @@ func resourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 	localEgressRules := d.Get("egress").(*schema.Set).List()
 
 	// Loop through the local state of rules, doing a match against the remote
-	// ruleSet we built above.
-	ingressRules := matchRules("ingress", localIngressRules, remoteIngressRules)
-	egressRules := matchRules("egress", localEgressRules, remoteEgressRules)
+	// ruleSet we built above. Unless the rules are managed exclusively, remote
+	// rules which are not declared inline are left to other resources.
+	exclusive := ruleExclusive(d)
+	d.Set("exclusive", exclusive)
+	ingressRules := matchRules("ingress", localIngressRules, remoteIngressRules, exclusive)
+	egressRules := matchRules("egress", localEgressRules, remoteEgressRules, exclusive)
 
 	sgArn := arn.ARN{
 		AccountID: aws.StringValue(sg.OwnerId),
@@ -397,6 +408,85 @@ func resourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func resourceAwsSecurityGroupCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("exclusive").(bool) {
+		return nil
+	}
+
+	var removed []string
+	for _, ruleType := range []string{"ingress", "egress"} {
+		if !diff.NewValueKnown(ruleType) {
+			return nil
+		}
+
+		o, n := diff.GetChange(ruleType)
+		newKeys := make(map[string]bool)
+		for _, key := range securityGroupInlineRuleKeys(diff.Id(), ruleType, n.(*schema.Set)) {
+			newKeys[key] = true
+		}
+		for _, key := range securityGroupInlineRuleKeys(diff.Id(), ruleType, o.(*schema.Set)) {
+			if !newKeys[key] {
+				removed = append(removed, key)
+			}
+		}
+	}
+
+	return meta.(*AWSClient).ruleOwnership.inlineRemovals(diff.Id(), removed)
+}
+
+// securityGroupInlineRuleKeys returns the keys of all the rules declared by
+// the given ingress or egress blocks, one for each of their sources.
+func securityGroupInlineRuleKeys(groupID, ruleType string, rules *schema.Set) []string {
+	var keys []string
+
+	for _, raw := range rules.List() {
+		rule := raw.(map[string]interface{})
+
+		var sources []string
+		for _, k := range []string{"cidr_blocks", "ipv6_cidr_blocks", "prefix_list_ids"} {
+			if v, ok := rule[k]; ok {
+				for _, source := range v.([]interface{}) {
+					sources = append(sources, source.(string))
+				}
+			}
+		}
+		if v, ok := rule["security_groups"]; ok {
+			for _, source := range v.(*schema.Set).List() {
+				sources = append(sources, source.(string))
+			}
+		}
+		if v, ok := rule["self"]; ok && v.(bool) {
+			sources = append(sources, groupID)
+		}
+
+		for _, source := range sources {
+			keys = append(keys, securityGroupRuleKey(groupID, ruleType, rule["protocol"].(string), rule["from_port"].(int), rule["to_port"].(int), source))
+		}
+	}
+
+	return keys
+}
+
+// securityGroupRuleKey identifies a single security group rule, whether it is
+// declared inline or by an aws_security_group_rule resource.
+func securityGroupRuleKey(groupID, ruleType, protocol string, fromPort, toPort int, source string) string {
+	// Security groups of other accounts are prefixed with the account ID.
+	if i := strings.Index(source, "/sg-"); i >= 0 {
+		source = source[i+1:]
+	}
+	if source == groupID {
+		source = "self"
+	}
+
+	// Ports are meaningless for all protocols and returned by AWS as 0.
+	protocol = protocolForValue(protocol)
+	if protocol == "-1" {
+		fromPort, toPort = 0, 0
+	}
+
+	return fmt.Sprintf("%s-%s-%d-%d-%s", ruleType, protocol, fromPort, toPort, source)
+}
+
 func resourceAwsSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -839,7 +929,7 @@ func waitForSgToExist(conn *ec2.EC2, id string, timeout time.Duration) (interfac
 //
 // If no match is found, we'll write the remote rule to state and let the graph
 // sort things out
-func matchRules(rType string, local []interface{}, remote []map[string]interface{}) []map[string]interface{} {
+func matchRules(rType string, local []interface{}, remote []map[string]interface{}, exclusive bool) []map[string]interface{} {
 	// For each local ip or security_group, we need to match against the remote
 	// ruleSet until all ips or security_groups are found
 
@@ -1117,6 +1207,10 @@ func matchRules(rType string, local []interface{}, remote []map[string]interface
 			}
 		}
 	}
+	if !exclusive {
+		return saves
+	}
+
 	// Here we catch any remote rules that have not been stripped of all self,
 	// cidrs, and security groups. We'll add remote rules here that have not been
 	// matched locally, and let the graph sort things out. This will happen when
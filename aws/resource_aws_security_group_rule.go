@@ -27,6 +27,8 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 		SchemaVersion: 2,
 		MigrateState:  resourceAwsSecurityGroupRuleMigrateState,
 
+		CustomizeDiff: resourceAwsSecurityGroupRuleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Type:        schema.TypeString,
@@ -115,6 +117,36 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 	}
 }
 
+func resourceAwsSecurityGroupRuleCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("security_group_id") {
+		return nil
+	}
+	groupID := diff.Get("security_group_id").(string)
+
+	var sources []string
+	for _, k := range []string{"cidr_blocks", "ipv6_cidr_blocks", "prefix_list_ids"} {
+		if !diff.NewValueKnown(k) {
+			return nil
+		}
+		for _, source := range diff.Get(k).([]interface{}) {
+			sources = append(sources, source.(string))
+		}
+	}
+	if v := diff.Get("source_security_group_id").(string); v != "" {
+		sources = append(sources, v)
+	}
+	if diff.Get("self").(bool) {
+		sources = append(sources, groupID)
+	}
+
+	keys := make([]string, 0, len(sources))
+	for _, source := range sources {
+		keys = append(keys, securityGroupRuleKey(groupID, diff.Get("type").(string), diff.Get("protocol").(string), diff.Get("from_port").(int), diff.Get("to_port").(int), source))
+	}
+
+	return meta.(*AWSClient).ruleOwnership.claimStandalone(groupID, "aws_security_group_rule", keys)
+}
+
 func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	sg_id := d.Get("security_group_id").(string)
@@ -587,7 +587,7 @@ func TestRulesMixedMatching(t *testing.T) {
 		},
 	}
 	for i, c := range cases {
-		saves := matchRules("ingress", c.local, c.remote)
+		saves := matchRules("ingress", c.local, c.remote, true)
 		log.Printf("\n======\n\nSaves:\n%#v\n\nCS Saves:\n%#v\n\n======\n", saves, c.saves)
 		log.Printf("\n\tTest %d:\n", i)
 
@@ -1656,6 +1656,68 @@ func TestAccAWSSecurityGroup_invalidCIDRBlock(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecurityGroup_nonExclusive(t *testing.T) {
+	var group ec2.SecurityGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupConfigInlineAndStandaloneRules(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					resource.TestCheckResourceAttr("aws_security_group.test", "exclusive", "false"),
+					resource.TestCheckResourceAttr("aws_security_group.test", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("aws_security_group.test", "egress.#", "0"),
+					testAccCheckAWSSecurityGroupIngressPermissionCount(&group, 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroup_inlineAndStandaloneRulesConflict(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSecurityGroupConfigInlineAndStandaloneRules(rName, true),
+				ExpectError: regexp.MustCompile(`(would remove rules managed by standalone resources|also managed by the inline rules)`),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityGroupIngressPermissionCount(group *ec2.SecurityGroup, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		resp, err := conn.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+			GroupIds: []*string{group.GroupId},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.SecurityGroups) != 1 {
+			return fmt.Errorf("Security Group (%s) not found", aws.StringValue(group.GroupId))
+		}
+
+		if actual := len(resp.SecurityGroups[0].IpPermissions); actual != expected {
+			return fmt.Errorf("expected %d ingress permissions, got %d: %s", expected, actual, resp.SecurityGroups[0].IpPermissions)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSSecurityGroupDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -3916,3 +3978,37 @@ resource "aws_security_group" "test" {
   }
 }
 `
+
+func testAccAWSSecurityGroupConfigInlineAndStandaloneRules(rName string, exclusive bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-security-group-inline-and-standalone-rules"
+  }
+}
+
+resource "aws_security_group" "test" {
+  name      = %[1]q
+  vpc_id    = "${aws_vpc.test.id}"
+  exclusive = %[2]t
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 80
+    to_port     = 80
+    cidr_blocks = ["10.0.0.0/8"]
+  }
+}
+
+resource "aws_security_group_rule" "test" {
+  type              = "ingress"
+  protocol          = "tcp"
+  from_port         = 443
+  to_port           = 443
+  cidr_blocks       = ["10.0.0.0/8"]
+  security_group_id = "${aws_security_group.test.id}"
+}
+`, rName, exclusive)
+}
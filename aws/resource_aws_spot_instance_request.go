@@ -29,6 +29,10 @@ func resourceAwsSpotInstanceRequest() *schema.Resource {
 			// The Spot Instance Request Schema is based on the AWS Instance schema.
 			s := resourceAwsInstance().Schema
 
+			// A spot instance is always replaced, so its user data can't be
+			// updated in place.
+			delete(s, "update_user_data_in_place")
+
 			// Everything on a spot instance is ForceNew except tags
 			for k, v := range s {
 				if k == "tags" {
@@ -37,6 +41,14 @@ func resourceAwsSpotInstanceRequest() *schema.Resource {
 				v.ForceNew = true
 			}
 
+			// Root block device attributes which aws_instance can modify in
+			// place must also be ForceNew.
+			for _, v := range s["root_block_device"].Elem.(*schema.Resource).Schema {
+				if !v.Computed || v.Optional {
+					v.ForceNew = true
+				}
+			}
+
 			s["volume_tags"] = &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -56,6 +56,19 @@ func resourceAwsSsmActivation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_activation_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -112,7 +125,9 @@ func resourceAwsSsmActivationCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("ActivationId was nil")
 	}
 	d.SetId(*resp.ActivationId)
-	d.Set("activation_code", resp.ActivationCode)
+	if err := setPgpEncryptedAttribute(d, "activation_code", aws.StringValue(resp.ActivationCode), "SSM Activation Code"); err != nil {
+		return err
+	}
 
 	return resourceAwsSsmActivationRead(d, meta)
 }
@@ -57,6 +57,28 @@ func TestAccAWSSSMActivation_expirationDate(t *testing.T) {
 	})
 }
 
+func TestAccAWSSSMActivation_pgpKey(t *testing.T) {
+	name := acctest.RandString(10)
+	resourceName := "aws_ssm_activation.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMActivationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMActivationConfig_pgpKey(name, testPubKey1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMActivationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activation_code", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "key_fingerprint"),
+					testDecryptAttributeAndTest(resourceName, "encrypted_activation_code", testPrivKey1),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSSSMActivationExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -194,3 +216,40 @@ resource "aws_ssm_activation" "foo" {
 }
 `, rName, expirationDate)
 }
+
+func testAccAWSSSMActivationConfig_pgpKey(rName, key string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test_role" {
+  name = "test_role-%[1]s"
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ssm.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test_attach" {
+  role = "${aws_iam_role.test_role.name}"
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AmazonEC2RoleforSSM"
+}
+
+resource "aws_ssm_activation" "foo" {
+  name       = "test_ssm_activation-%[1]s"
+  iam_role   = "${aws_iam_role.test_role.name}"
+  depends_on = ["aws_iam_role_policy_attachment.test_attach"]
+
+  pgp_key = <<EOF
+%[2]s
+EOF
+}
+`, rName, key)
+}
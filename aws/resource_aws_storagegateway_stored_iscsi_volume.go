@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsStorageGatewayStoredIscsiVolume() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsStorageGatewayStoredIscsiVolumeCreate,
+		Read:   resourceAwsStorageGatewayStoredIscsiVolumeRead,
+		Delete: resourceAwsStorageGatewayStoredIscsiVolumeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"chap_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"disk_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"kms_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kms_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"lun_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			// Poor API naming: this accepts the IP address of the network interface
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_interface_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"preserve_existing_data": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"volume_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"volume_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsStorageGatewayStoredIscsiVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	diskID := d.Get("disk_id").(string)
+	gatewayARN := d.Get("gateway_arn").(string)
+
+	// Catch disks which don't belong to the gateway before creating the volume.
+	disk, err := storageGatewayLocalDisk(conn, gatewayARN, func(disk *storagegateway.Disk) bool {
+		return aws.StringValue(disk.DiskId) == diskID
+	})
+	if err != nil {
+		return fmt.Errorf("error finding Storage Gateway local disk %q: %s", diskID, err)
+	}
+	log.Printf("[DEBUG] Storage Gateway local disk %q allocation type: %s", diskID, aws.StringValue(disk.DiskAllocationType))
+
+	input := &storagegateway.CreateStorediSCSIVolumeInput{
+		DiskId:               aws.String(diskID),
+		GatewayARN:           aws.String(gatewayARN),
+		NetworkInterfaceId:   aws.String(d.Get("network_interface_id").(string)),
+		PreserveExistingData: aws.Bool(d.Get("preserve_existing_data").(bool)),
+		TargetName:           aws.String(d.Get("target_name").(string)),
+	}
+
+	if v, ok := d.GetOk("kms_encrypted"); ok {
+		input.KMSEncrypted = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("kms_key"); ok {
+		input.KMSKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_id"); ok {
+		input.SnapshotId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Storage Gateway stored iSCSI volume: %s", input)
+	output, err := conn.CreateStorediSCSIVolume(input)
+	if err != nil {
+		return fmt.Errorf("error creating Storage Gateway stored iSCSI volume: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VolumeARN))
+
+	return resourceAwsStorageGatewayStoredIscsiVolumeRead(d, meta)
+}
+
+func resourceAwsStorageGatewayStoredIscsiVolumeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	input := &storagegateway.DescribeStorediSCSIVolumesInput{
+		VolumeARNs: []*string{aws.String(d.Id())},
+	}
+
+	log.Printf("[DEBUG] Reading Storage Gateway stored iSCSI volume: %s", input)
+	output, err := conn.DescribeStorediSCSIVolumes(input)
+
+	if err != nil {
+		if isAWSErr(err, storagegateway.ErrorCodeVolumeNotFound, "") {
+			log.Printf("[WARN] Storage Gateway stored iSCSI volume %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Storage Gateway stored iSCSI volume %q: %s", d.Id(), err)
+	}
+
+	if output == nil || len(output.StorediSCSIVolumes) == 0 || output.StorediSCSIVolumes[0] == nil || aws.StringValue(output.StorediSCSIVolumes[0].VolumeARN) != d.Id() {
+		log.Printf("[WARN] Storage Gateway stored iSCSI volume %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	volume := output.StorediSCSIVolumes[0]
+
+	d.Set("arn", aws.StringValue(volume.VolumeARN))
+	d.Set("disk_id", aws.StringValue(volume.VolumeDiskId))
+	d.Set("kms_encrypted", volume.KMSKey != nil)
+	d.Set("kms_key", aws.StringValue(volume.KMSKey))
+	d.Set("preserve_existing_data", aws.BoolValue(volume.PreservedExistingData))
+	d.Set("snapshot_id", aws.StringValue(volume.SourceSnapshotId))
+	d.Set("volume_arn", aws.StringValue(volume.VolumeARN))
+	d.Set("volume_id", aws.StringValue(volume.VolumeId))
+	d.Set("volume_size_in_bytes", int(aws.Int64Value(volume.VolumeSizeInBytes)))
+	d.Set("volume_status", aws.StringValue(volume.VolumeStatus))
+
+	if volume.VolumeiSCSIAttributes != nil {
+		d.Set("chap_enabled", aws.BoolValue(volume.VolumeiSCSIAttributes.ChapEnabled))
+		d.Set("lun_number", int(aws.Int64Value(volume.VolumeiSCSIAttributes.LunNumber)))
+		d.Set("network_interface_id", aws.StringValue(volume.VolumeiSCSIAttributes.NetworkInterfaceId))
+		d.Set("network_interface_port", int(aws.Int64Value(volume.VolumeiSCSIAttributes.NetworkInterfacePort)))
+
+		targetARN := aws.StringValue(volume.VolumeiSCSIAttributes.TargetARN)
+		d.Set("target_arn", targetARN)
+
+		gatewayARN, targetName, err := parseStorageGatewayVolumeGatewayARNAndTargetNameFromARN(targetARN)
+		if err != nil {
+			return fmt.Errorf("error parsing Storage Gateway volume gateway ARN and target name from target ARN %q: %s", targetARN, err)
+		}
+		d.Set("gateway_arn", gatewayARN)
+		d.Set("target_name", targetName)
+	}
+
+	return nil
+}
+
+func resourceAwsStorageGatewayStoredIscsiVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	input := &storagegateway.DeleteVolumeInput{
+		VolumeARN: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Storage Gateway stored iSCSI volume: %s", input)
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteVolume(input)
+		if err != nil {
+			if isAWSErr(err, storagegateway.ErrorCodeVolumeNotFound, "") {
+				return nil
+			}
+			// InvalidGatewayRequestException: The specified gateway is not connected.
+			// Can occur during concurrent DeleteVolume operations
+			if isAWSErr(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway is not connected") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Storage Gateway stored iSCSI volume %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSStorageGatewayStoredIscsiVolume_Basic(t *testing.T) {
+	var storedIscsiVolume storagegateway.StorediSCSIVolume
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayStoredIscsiVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayStoredIscsiVolumeConfig_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName, &storedIscsiVolume),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:storagegateway:[^:]+:\d{12}:gateway/sgw-.+/volume/vol-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "chap_enabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "disk_id", "data.aws_storagegateway_local_disk.test", "disk_id"),
+					resource.TestMatchResourceAttr(resourceName, "gateway_arn", regexp.MustCompile(`^arn:[^:]+:storagegateway:[^:]+:\d{12}:gateway/sgw-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "lun_number", "0"),
+					resource.TestMatchResourceAttr(resourceName, "network_interface_id", regexp.MustCompile(`^\d+\.\d+\.\d+\.\d+$`)),
+					resource.TestMatchResourceAttr(resourceName, "network_interface_port", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "preserve_existing_data", "false"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_id", ""),
+					resource.TestMatchResourceAttr(resourceName, "target_arn", regexp.MustCompile(fmt.Sprintf("^arn:[^:]+:storagegateway:[^:]+:\\d{12}:gateway/sgw-.+/target/iqn.1997-05.com.amazon:%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "target_name", rName),
+					resource.TestMatchResourceAttr(resourceName, "volume_id", regexp.MustCompile(`^vol-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "volume_size_in_bytes", "10737418240"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayStoredIscsiVolume_SnapshotId(t *testing.T) {
+	var storedIscsiVolume storagegateway.StorediSCSIVolume
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayStoredIscsiVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayStoredIscsiVolumeConfig_SnapshotId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName, &storedIscsiVolume),
+					resource.TestMatchResourceAttr(resourceName, "snapshot_id", regexp.MustCompile(`^snap-.+$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName string, storedIscsiVolume *storagegateway.StorediSCSIVolume) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+		input := &storagegateway.DescribeStorediSCSIVolumesInput{
+			VolumeARNs: []*string{aws.String(rs.Primary.ID)},
+		}
+
+		output, err := conn.DescribeStorediSCSIVolumes(input)
+
+		if err != nil {
+			return fmt.Errorf("error reading Storage Gateway stored iSCSI volume: %s", err)
+		}
+
+		if output == nil || len(output.StorediSCSIVolumes) == 0 || output.StorediSCSIVolumes[0] == nil || aws.StringValue(output.StorediSCSIVolumes[0].VolumeARN) != rs.Primary.ID {
+			return fmt.Errorf("Storage Gateway stored iSCSI volume %q not found", rs.Primary.ID)
+		}
+
+		*storedIscsiVolume = *output.StorediSCSIVolumes[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAWSStorageGatewayStoredIscsiVolumeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_storagegateway_stored_iscsi_volume" {
+			continue
+		}
+
+		input := &storagegateway.DescribeStorediSCSIVolumesInput{
+			VolumeARNs: []*string{aws.String(rs.Primary.ID)},
+		}
+
+		output, err := conn.DescribeStorediSCSIVolumes(input)
+
+		if err != nil {
+			if isAWSErrStorageGatewayGatewayNotFound(err) {
+				return nil
+			}
+			if isAWSErr(err, storagegateway.ErrorCodeVolumeNotFound, "") {
+				return nil
+			}
+			return err
+		}
+
+		if output != nil && len(output.StorediSCSIVolumes) > 0 && output.StorediSCSIVolumes[0] != nil && aws.StringValue(output.StorediSCSIVolumes[0].VolumeARN) == rs.Primary.ID {
+			return fmt.Errorf("Storage Gateway stored iSCSI volume %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSStorageGatewayStoredIscsiVolumeConfigBase(rName string) string {
+	return testAccAWSStorageGatewayGatewayConfig_GatewayType_Stored(rName) + fmt.Sprintf(`
+resource "aws_ebs_volume" "buffer" {
+  availability_zone = "${aws_instance.test.availability_zone}"
+  size              = 10
+  type              = "gp2"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_volume_attachment" "buffer" {
+  device_name  = "/dev/xvdb"
+  force_detach = true
+  instance_id  = "${aws_instance.test.id}"
+  volume_id    = "${aws_ebs_volume.buffer.id}"
+}
+
+data "aws_storagegateway_local_disk" "buffer" {
+  disk_path   = "${aws_volume_attachment.buffer.device_name}"
+  gateway_arn = "${aws_storagegateway_gateway.test.arn}"
+}
+
+resource "aws_storagegateway_upload_buffer" "test" {
+  # ACCEPTANCE TESTING WORKAROUND:
+  # Data sources are not refreshed before plan after apply in TestStep
+  # We expect this data source value to change due to how Storage Gateway works.
+  lifecycle {
+    ignore_changes = ["disk_id"]
+  }
+
+  disk_id     = "${data.aws_storagegateway_local_disk.buffer.id}"
+  gateway_arn = "${aws_storagegateway_gateway.test.arn}"
+}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = "${aws_instance.test.availability_zone}"
+  size              = 10
+  type              = "gp2"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_volume_attachment" "test" {
+  device_name  = "/dev/xvdc"
+  force_detach = true
+  instance_id  = "${aws_instance.test.id}"
+  volume_id    = "${aws_ebs_volume.test.id}"
+}
+
+data "aws_storagegateway_local_disk" "test" {
+  disk_path   = "${aws_volume_attachment.test.device_name}"
+  gateway_arn = "${aws_storagegateway_gateway.test.arn}"
+}
+`, rName)
+}
+
+func testAccAWSStorageGatewayStoredIscsiVolumeConfig_Basic(rName string) string {
+	return testAccAWSStorageGatewayStoredIscsiVolumeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_stored_iscsi_volume" "test" {
+  disk_id                = "${data.aws_storagegateway_local_disk.test.id}"
+  gateway_arn            = "${aws_storagegateway_upload_buffer.test.gateway_arn}"
+  network_interface_id   = "${aws_instance.test.private_ip}"
+  preserve_existing_data = false
+  target_name            = %q
+}
+`, rName)
+}
+
+func testAccAWSStorageGatewayStoredIscsiVolumeConfig_SnapshotId(rName string) string {
+	return testAccAWSStorageGatewayStoredIscsiVolumeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ebs_volume" "snapvolume" {
+  availability_zone = "${aws_instance.test.availability_zone}"
+  size              = 10
+  type              = "gp2"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = "${aws_ebs_volume.snapvolume.id}"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_storagegateway_stored_iscsi_volume" "test" {
+  disk_id                = "${data.aws_storagegateway_local_disk.test.id}"
+  gateway_arn            = "${aws_storagegateway_upload_buffer.test.gateway_arn}"
+  network_interface_id   = "${aws_instance.test.private_ip}"
+  preserve_existing_data = false
+  snapshot_id            = "${aws_ebs_snapshot.test.id}"
+  target_name            = %[1]q
+}
+`, rName)
+}
@@ -0,0 +1,278 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Tape statuses, which the Storage Gateway API does not define as enums.
+const (
+	storageGatewayTapeStatusArchived  = "ARCHIVED"
+	storageGatewayTapeStatusAvailable = "AVAILABLE"
+	storageGatewayTapeStatusCreating  = "CREATING"
+)
+
+func resourceAwsStorageGatewayTape() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsStorageGatewayTapeCreate,
+		Read:   resourceAwsStorageGatewayTapeRead,
+		Delete: resourceAwsStorageGatewayTapeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"kms_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kms_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"tape_barcode": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"tape_barcode_prefix"},
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^[A-Z0-9]{7,16}$`), "must be 7 to 16 uppercase letters or numbers"),
+			},
+			"tape_barcode_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"tape_barcode"},
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^[A-Z]{1,4}$`), "must be 1 to 4 uppercase letters"),
+			},
+			"tape_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"tape_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsStorageGatewayTapeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	gatewayARN := d.Get("gateway_arn").(string)
+	tapeSizeInBytes := int64(d.Get("tape_size_in_bytes").(int))
+
+	var kmsEncrypted *bool
+	if v, ok := d.GetOk("kms_encrypted"); ok {
+		kmsEncrypted = aws.Bool(v.(bool))
+	}
+
+	var kmsKey *string
+	if v, ok := d.GetOk("kms_key"); ok {
+		kmsKey = aws.String(v.(string))
+	}
+
+	var tapeARN string
+
+	if v, ok := d.GetOk("tape_barcode"); ok {
+		input := &storagegateway.CreateTapeWithBarcodeInput{
+			GatewayARN:      aws.String(gatewayARN),
+			KMSEncrypted:    kmsEncrypted,
+			KMSKey:          kmsKey,
+			TapeBarcode:     aws.String(v.(string)),
+			TapeSizeInBytes: aws.Int64(tapeSizeInBytes),
+		}
+
+		log.Printf("[DEBUG] Creating Storage Gateway tape: %s", input)
+		output, err := conn.CreateTapeWithBarcode(input)
+		if err != nil {
+			return fmt.Errorf("error creating Storage Gateway tape: %s", err)
+		}
+
+		tapeARN = aws.StringValue(output.TapeARN)
+	} else if v, ok := d.GetOk("tape_barcode_prefix"); ok {
+		input := &storagegateway.CreateTapesInput{
+			ClientToken:       aws.String(resource.UniqueId()),
+			GatewayARN:        aws.String(gatewayARN),
+			KMSEncrypted:      kmsEncrypted,
+			KMSKey:            kmsKey,
+			NumTapesToCreate:  aws.Int64(1),
+			TapeBarcodePrefix: aws.String(v.(string)),
+			TapeSizeInBytes:   aws.Int64(tapeSizeInBytes),
+		}
+
+		log.Printf("[DEBUG] Creating Storage Gateway tape: %s", input)
+		output, err := conn.CreateTapes(input)
+		if err != nil {
+			return fmt.Errorf("error creating Storage Gateway tape: %s", err)
+		}
+
+		if output == nil || len(output.TapeARNs) == 0 {
+			return errors.New("error creating Storage Gateway tape: empty response")
+		}
+
+		tapeARN = aws.StringValue(output.TapeARNs[0])
+	} else {
+		return errors.New("one of tape_barcode or tape_barcode_prefix must be configured")
+	}
+
+	d.SetId(tapeARN)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{storageGatewayTapeStatusCreating},
+		Target:  []string{storageGatewayTapeStatusAvailable},
+		Refresh: storageGatewayTapeRefreshFunc(conn, d.Id()),
+		Timeout: 10 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Storage Gateway tape %q creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsStorageGatewayTapeRead(d, meta)
+}
+
+func resourceAwsStorageGatewayTapeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	tapeInfo, err := storageGatewayTapeInfo(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Storage Gateway tape %q: %s", d.Id(), err)
+	}
+
+	if tapeInfo == nil {
+		log.Printf("[WARN] Storage Gateway tape %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", aws.StringValue(tapeInfo.TapeARN))
+	// Archived tapes are no longer associated with a gateway, so the gateway
+	// they were created in is kept.
+	if gatewayARN := aws.StringValue(tapeInfo.GatewayARN); gatewayARN != "" {
+		d.Set("gateway_arn", gatewayARN)
+	}
+	d.Set("tape_barcode", aws.StringValue(tapeInfo.TapeBarcode))
+	d.Set("tape_size_in_bytes", int(aws.Int64Value(tapeInfo.TapeSizeInBytes)))
+	d.Set("tape_status", aws.StringValue(tapeInfo.TapeStatus))
+
+	// Archived tapes are no longer in the gateway's virtual tape library.
+	if aws.StringValue(tapeInfo.TapeStatus) == storageGatewayTapeStatusArchived {
+		return nil
+	}
+
+	input := &storagegateway.DescribeTapesInput{
+		GatewayARN: tapeInfo.GatewayARN,
+		TapeARNs:   []*string{aws.String(d.Id())},
+	}
+
+	log.Printf("[DEBUG] Reading Storage Gateway tape: %s", input)
+	output, err := conn.DescribeTapes(input)
+	if err != nil {
+		if isAWSErrStorageGatewayGatewayNotFound(err) {
+			log.Printf("[WARN] Storage Gateway tape %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Storage Gateway tape %q: %s", d.Id(), err)
+	}
+
+	if output != nil && len(output.Tapes) > 0 && output.Tapes[0] != nil {
+		tape := output.Tapes[0]
+		d.Set("kms_encrypted", tape.KMSKey != nil)
+		d.Set("kms_key", aws.StringValue(tape.KMSKey))
+	}
+
+	return nil
+}
+
+func resourceAwsStorageGatewayTapeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	var err error
+	if d.Get("tape_status").(string) == storageGatewayTapeStatusArchived {
+		input := &storagegateway.DeleteTapeArchiveInput{
+			TapeARN: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Deleting Storage Gateway tape archive: %s", input)
+		_, err = conn.DeleteTapeArchive(input)
+	} else {
+		input := &storagegateway.DeleteTapeInput{
+			GatewayARN: aws.String(d.Get("gateway_arn").(string)),
+			TapeARN:    aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Deleting Storage Gateway tape: %s", input)
+		_, err = conn.DeleteTape(input)
+	}
+	if err != nil {
+		if isAWSErrStorageGatewayGatewayNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error deleting Storage Gateway tape %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// storageGatewayTapeInfo returns the tape from the list of all tapes in the
+// account, as describing a tape requires the ARN of the gateway it's in.
+func storageGatewayTapeInfo(conn *storagegateway.StorageGateway, tapeARN string) (*storagegateway.TapeInfo, error) {
+	input := &storagegateway.ListTapesInput{
+		TapeARNs: []*string{aws.String(tapeARN)},
+	}
+
+	log.Printf("[DEBUG] Listing Storage Gateway tapes: %s", input)
+	output, err := conn.ListTapes(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tapeInfo := range output.TapeInfos {
+		if aws.StringValue(tapeInfo.TapeARN) == tapeARN {
+			return tapeInfo, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func storageGatewayTapeRefreshFunc(conn *storagegateway.StorageGateway, tapeARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		tapeInfo, err := storageGatewayTapeInfo(conn, tapeARN)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if tapeInfo == nil {
+			return nil, "", nil
+		}
+
+		return tapeInfo, aws.StringValue(tapeInfo.TapeStatus), nil
+	}
+}
@@ -0,0 +1,213 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAwsStorageGatewayTapeRead_archived(t *testing.T) {
+	gatewayARN := "arn:aws:storagegateway:us-east-1:123456789012:gateway/sgw-12345678"
+	tapeARN := "arn:aws:storagegateway:us-east-1:123456789012:tape/TEST12345"
+
+	// DescribeTapes isn't mocked, as archived tapes are not in a gateway.
+	endpoints := []*awsMockEndpoint{
+		{
+			Request: &awsMockRequest{"POST", "/", fmt.Sprintf(`{"TapeARNs":[%q]}`, tapeARN)},
+			Response: &awsMockResponse{200, fmt.Sprintf(`{"TapeInfos":[{"TapeARN":%q,"TapeBarcode":"TEST12345","TapeSizeInBytes":107374182400,"TapeStatus":"ARCHIVED"}]}`, tapeARN),
+				"application/x-amz-json-1.1"},
+		},
+	}
+	closeFunc, sess, err := getMockedAwsApiSession("StorageGateway", endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	d := resourceAwsStorageGatewayTape().TestResourceData()
+	d.SetId(tapeARN)
+	d.Set("gateway_arn", gatewayARN)
+
+	err = resourceAwsStorageGatewayTapeRead(d, &AWSClient{storagegatewayconn: storagegateway.New(sess)})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if d.Id() != tapeARN {
+		t.Fatalf("expected tape to remain in state, got ID %q", d.Id())
+	}
+	if v := d.Get("gateway_arn").(string); v != gatewayARN {
+		t.Errorf("expected gateway_arn %q, got %q", gatewayARN, v)
+	}
+	if v := d.Get("tape_status").(string); v != "ARCHIVED" {
+		t.Errorf("expected tape_status ARCHIVED, got %q", v)
+	}
+}
+
+func TestAccAWSStorageGatewayTape_TapeBarcode(t *testing.T) {
+	var tapeInfo storagegateway.TapeInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_tape.test"
+	barcode := fmt.Sprintf("TF%05d", acctest.RandIntRange(0, 99999))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayTapeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayTapeConfig_TapeBarcode(rName, barcode),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayTapeExists(resourceName, &tapeInfo),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:storagegateway:[^:]+:\d{12}:tape/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_arn", "aws_storagegateway_gateway.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tape_barcode", barcode),
+					resource.TestCheckResourceAttr(resourceName, "tape_size_in_bytes", "107374182400"),
+					resource.TestCheckResourceAttr(resourceName, "tape_status", "AVAILABLE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayTape_TapeBarcodePrefix(t *testing.T) {
+	var tapeInfo storagegateway.TapeInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_tape.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayTapeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayTapeConfig_TapeBarcodePrefix(rName, "TFAC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayTapeExists(resourceName, &tapeInfo),
+					resource.TestMatchResourceAttr(resourceName, "tape_barcode", regexp.MustCompile(`^TFAC`)),
+					resource.TestCheckResourceAttr(resourceName, "tape_barcode_prefix", "TFAC"),
+					resource.TestCheckResourceAttr(resourceName, "tape_status", "AVAILABLE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tape_barcode_prefix"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSStorageGatewayTapeExists(resourceName string, tapeInfo *storagegateway.TapeInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+		output, err := storageGatewayTapeInfo(conn, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error reading Storage Gateway tape: %s", err)
+		}
+
+		if output == nil {
+			return fmt.Errorf("Storage Gateway tape %q not found", rs.Primary.ID)
+		}
+
+		*tapeInfo = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSStorageGatewayTapeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_storagegateway_tape" {
+			continue
+		}
+
+		output, err := storageGatewayTapeInfo(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Storage Gateway tape %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSStorageGatewayTapeConfigBase(rName string) string {
+	return testAccAWSStorageGatewayGatewayConfig_GatewayType_Vtl(rName) + fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = "${aws_instance.test.availability_zone}"
+  size              = 150
+  type              = "gp2"
+
+  tags {
+    Name = %q
+  }
+}
+
+resource "aws_volume_attachment" "test" {
+  device_name  = "/dev/xvdc"
+  force_detach = true
+  instance_id  = "${aws_instance.test.id}"
+  volume_id    = "${aws_ebs_volume.test.id}"
+}
+
+data "aws_storagegateway_local_disk" "test" {
+  disk_path   = "${aws_volume_attachment.test.device_name}"
+  gateway_arn = "${aws_storagegateway_gateway.test.arn}"
+}
+
+resource "aws_storagegateway_cache" "test" {
+  # ACCEPTANCE TESTING WORKAROUND:
+  # Data sources are not refreshed before plan after apply in TestStep
+  # We expect this data source value to change due to how Storage Gateway works.
+  lifecycle {
+    ignore_changes = ["disk_id"]
+  }
+
+  disk_id     = "${data.aws_storagegateway_local_disk.test.id}"
+  gateway_arn = "${aws_storagegateway_gateway.test.arn}"
+}
+`, rName)
+}
+
+func testAccAWSStorageGatewayTapeConfig_TapeBarcode(rName, barcode string) string {
+	return testAccAWSStorageGatewayTapeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_tape" "test" {
+  gateway_arn        = "${aws_storagegateway_cache.test.gateway_arn}"
+  tape_barcode       = %q
+  tape_size_in_bytes = 107374182400
+}
+`, barcode)
+}
+
+func testAccAWSStorageGatewayTapeConfig_TapeBarcodePrefix(rName, prefix string) string {
+	return testAccAWSStorageGatewayTapeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_tape" "test" {
+  gateway_arn         = "${aws_storagegateway_cache.test.gateway_arn}"
+  tape_barcode_prefix = %q
+  tape_size_in_bytes  = 107374182400
+}
+`, prefix)
+}
@@ -303,8 +303,14 @@ func resourceAwsSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
 	log.Printf("[INFO] Deleting subnet: %s", d.Id())
+	return deleteAwsSubnet(conn, d.Id())
+}
+
+// deleteAwsSubnet deletes the subnet, retrying while it has dependencies
+// which are still being deleted.
+func deleteAwsSubnet(conn *ec2.EC2, id string) error {
 	req := &ec2.DeleteSubnetInput{
-		SubnetId: aws.String(d.Id()),
+		SubnetId: aws.String(id),
 	}
 
 	wait := resource.StateChangeConf{
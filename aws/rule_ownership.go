@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ruleOwnership detects rules of a security group, route table or network
+// ACL which are managed both inline by the parent resource and by a
+// standalone rule resource (e.g. aws_security_group_rule) in the same
+// configuration.
+//
+// Such rules flip-flop: the parent removes every rule it does not declare
+// and the standalone resource adds its rule back, on every apply. As both
+// resources are diffed by the same provider process during plan and apply,
+// the parent records the rules its inline form is about to remove and each
+// standalone resource records the rules it manages, and whichever side comes
+// second reports the overlap.
+//
+// Rules are identified by keys which must be built identically for both
+// forms, e.g. "ingress-tcp-80-80-10.0.0.0/8" for a security group rule.
+type ruleOwnership struct {
+	sync.Mutex
+
+	removed    map[string]map[string]bool
+	standalone map[string]map[string]string
+}
+
+func newRuleOwnership() *ruleOwnership {
+	return &ruleOwnership{
+		removed:    make(map[string]map[string]bool),
+		standalone: make(map[string]map[string]string),
+	}
+}
+
+// inlineRemovals records the rules that the inline form of the parent is
+// about to remove, and returns an error if any of them is managed by a
+// standalone resource.
+func (o *ruleOwnership) inlineRemovals(parentID string, keys []string) error {
+	if parentID == "" {
+		return nil
+	}
+
+	o.Lock()
+	defer o.Unlock()
+
+	// A diff of the parent replaces its earlier removals.
+	delete(o.removed, parentID)
+	if ruleKeysUnknown(keys) {
+		return nil
+	}
+
+	removed := make(map[string]bool, len(keys))
+	var conflicts []string
+	for _, key := range keys {
+		removed[key] = true
+
+		if resourceType, ok := o.standalone[parentID][key]; ok {
+			conflicts = append(conflicts, fmt.Sprintf("%s (%s)", key, resourceType))
+		}
+	}
+	o.removed[parentID] = removed
+
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return fmt.Errorf("the inline rules of %s would remove rules managed by standalone resources: %s. "+
+			"Manage each rule either inline or with a standalone resource, not both, "+
+			"or set exclusive = false to ignore rules not declared inline", parentID, strings.Join(conflicts, ", "))
+	}
+
+	return nil
+}
+
+// claimStandalone records the rules managed by a standalone resource of the
+// given type, and returns an error if the inline form of the parent is about
+// to remove any of them.
+func (o *ruleOwnership) claimStandalone(parentID, resourceType string, keys []string) error {
+	if parentID == "" || ruleKeysUnknown(append([]string{parentID}, keys...)) {
+		return nil
+	}
+
+	o.Lock()
+	defer o.Unlock()
+
+	if _, ok := o.standalone[parentID]; !ok {
+		o.standalone[parentID] = make(map[string]string)
+	}
+
+	var conflicts []string
+	for _, key := range keys {
+		o.standalone[parentID][key] = resourceType
+
+		if o.removed[parentID][key] {
+			conflicts = append(conflicts, key)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return fmt.Errorf("%s rules %s are also managed by the inline rules of %s, which would remove them. "+
+			"Manage each rule either inline or with a standalone resource, not both, "+
+			"or set exclusive = false on %s to ignore rules not declared inline", resourceType, strings.Join(conflicts, ", "), parentID, parentID)
+	}
+
+	return nil
+}
+
+// ruleKeysUnknown returns whether any key is built from values that are
+// not known yet, in which case claims cannot be compared reliably.
+func ruleKeysUnknown(keys []string) bool {
+	for _, key := range keys {
+		if strings.Contains(key, config.UnknownVariableValue) {
+			log.Printf("[DEBUG] Skipping rule ownership check of unknown rule %q", key)
+			return true
+		}
+	}
+
+	return false
+}
+
+// ruleExclusive returns whether the parent manages its rules exclusively.
+// State written by an import or before the exclusive argument existed has no
+// value for it, which is read as the default of true.
+func ruleExclusive(d *schema.ResourceData) bool {
+	if v, ok := d.GetOkExists("exclusive"); ok {
+		return v.(bool)
+	}
+
+	return true
+}
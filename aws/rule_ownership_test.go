@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestRuleOwnership(t *testing.T) {
+	o := newRuleOwnership()
+
+	// Standalone resources diffed first.
+	if err := o.claimStandalone("sg-1", "aws_security_group_rule", []string{"ingress-tcp-443-443-10.0.0.0/8"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := o.inlineRemovals("sg-1", []string{"ingress-tcp-22-22-10.0.0.0/8"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := o.inlineRemovals("sg-1", []string{"ingress-tcp-443-443-10.0.0.0/8"}); err == nil {
+		t.Fatal("expected conflict with standalone rule")
+	}
+
+	// Inline form diffed first.
+	if err := o.inlineRemovals("rtb-1", []string{"10.1.0.0/16"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := o.claimStandalone("rtb-1", "aws_route", []string{"10.2.0.0/16"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := o.claimStandalone("rtb-1", "aws_route", []string{"10.1.0.0/16"}); err == nil {
+		t.Fatal("expected conflict with inline rules")
+	}
+	if err := o.claimStandalone("rtb-2", "aws_route", []string{"10.1.0.0/16"}); err != nil {
+		t.Fatalf("unexpected error for another parent: %s", err)
+	}
+
+	// Unknown values are never compared.
+	if err := o.claimStandalone("rtb-1", "aws_route", []string{config.UnknownVariableValue}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := o.claimStandalone(config.UnknownVariableValue, "aws_route", []string{"10.1.0.0/16"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestRuleOwnership_migrateInlineToStandalone(t *testing.T) {
+	key := "ingress-tcp-443-443-10.0.0.0/8"
+
+	// The rule is removed from the inline rules while the standalone
+	// resource isn't configured yet.
+	plan := newRuleOwnership()
+	if err := plan.inlineRemovals("sg-1", []string{key}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A later plan with the standalone resource doesn't see the removal.
+	plan = newRuleOwnership()
+	if err := plan.inlineRemovals("sg-1", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := plan.claimStandalone("sg-1", "aws_security_group_rule", []string{key}); err != nil {
+		t.Fatalf("unexpected error after migration: %s", err)
+	}
+
+	// Nor does a diff of the parent which no longer removes the rule.
+	plan = newRuleOwnership()
+	if err := plan.inlineRemovals("sg-1", []string{key}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := plan.inlineRemovals("sg-1", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := plan.claimStandalone("sg-1", "aws_security_group_rule", []string{key}); err != nil {
+		t.Fatalf("unexpected error after the parent was diffed again: %s", err)
+	}
+}
+
+func TestRuleExclusive(t *testing.T) {
+	r := resourceAwsSecurityGroup()
+
+	// State imported or written before the argument existed.
+	d := r.TestResourceData()
+	d.SetId("sg-1")
+	if !ruleExclusive(d) {
+		t.Fatal("expected missing exclusive to default to true")
+	}
+
+	d.Set("exclusive", false)
+	if ruleExclusive(d) {
+		t.Fatal("expected exclusive to be false")
+	}
+}
+
+func TestRuleOwnershipParentSchemas(t *testing.T) {
+	parents := map[string]*schema.Resource{
+		"aws_default_network_acl":    resourceAwsDefaultNetworkAcl(),
+		"aws_default_route_table":    resourceAwsDefaultRouteTable(),
+		"aws_default_security_group": resourceAwsDefaultSecurityGroup(),
+		"aws_network_acl":            resourceAwsNetworkAcl(),
+		"aws_route_table":            resourceAwsRouteTable(),
+		"aws_security_group":         resourceAwsSecurityGroup(),
+	}
+
+	for name, r := range parents {
+		if r.CustomizeDiff == nil {
+			t.Errorf("%s: expected a CustomizeDiff checking rule ownership", name)
+		}
+
+		// Read sets exclusive, which fails for a key missing from the schema.
+		d := r.TestResourceData()
+		if err := d.Set("exclusive", true); err != nil {
+			t.Errorf("%s: %s", name, err)
+		}
+	}
+}
+
+func TestSecurityGroupRuleKey(t *testing.T) {
+	cases := []struct {
+		Protocol string
+		FromPort int
+		ToPort   int
+		Source   string
+		Expected string
+	}{
+		{"tcp", 80, 80, "10.0.0.0/8", "ingress-tcp-80-80-10.0.0.0/8"},
+		{"6", 80, 80, "10.0.0.0/8", "ingress-tcp-80-80-10.0.0.0/8"},
+		{"all", 0, 65535, "::/0", "ingress--1-0-0-::/0"},
+		{"tcp", 22, 22, "sg-1", "ingress-tcp-22-22-self"},
+		{"tcp", 22, 22, "123456789012/sg-2", "ingress-tcp-22-22-sg-2"},
+	}
+
+	for _, tc := range cases {
+		if actual := securityGroupRuleKey("sg-1", "ingress", tc.Protocol, tc.FromPort, tc.ToPort, tc.Source); actual != tc.Expected {
+			t.Errorf("expected %q, got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestSecurityGroupInlineRuleKeys(t *testing.T) {
+	rules := schema.NewSet(resourceAwsSecurityGroupRuleHash, []interface{}{
+		map[string]interface{}{
+			"protocol":         "tcp",
+			"from_port":        443,
+			"to_port":          443,
+			"cidr_blocks":      []interface{}{"10.0.0.0/8", "192.168.0.0/16"},
+			"ipv6_cidr_blocks": []interface{}{},
+			"prefix_list_ids":  []interface{}{},
+			"security_groups":  schema.NewSet(schema.HashString, []interface{}{"sg-2"}),
+			"self":             true,
+			"description":      "",
+		},
+	})
+
+	expected := map[string]bool{
+		"egress-tcp-443-443-10.0.0.0/8":     true,
+		"egress-tcp-443-443-192.168.0.0/16": true,
+		"egress-tcp-443-443-sg-2":           true,
+		"egress-tcp-443-443-self":           true,
+	}
+
+	actual := securityGroupInlineRuleKeys("sg-1", "egress", rules)
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d keys, got %v", len(expected), actual)
+	}
+	for _, key := range actual {
+		if !expected[key] {
+			t.Errorf("unexpected key %q", key)
+		}
+	}
+}
@@ -630,6 +630,17 @@ func validateS3BucketLifecycleStorageClass() schema.SchemaValidateFunc {
 	}, false)
 }
 
+func validateS3BucketObjectMetadata(v interface{}, k string) (ws []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf(
+				"%q keys must be lower case, S3 does not preserve their case: %q", k, key))
+		}
+	}
+
+	return
+}
+
 func validateDbEventSubscriptionName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[0-9A-Za-z-]+$`).MatchString(value) {
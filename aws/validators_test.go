@@ -622,6 +622,23 @@ func TestValidateS3BucketLifecycleTimestamp(t *testing.T) {
 	}
 }
 
+func TestValidateS3BucketObjectMetadata(t *testing.T) {
+	valid := map[string]interface{}{
+		"cdn-ttl":  "300",
+		"checksum": "abc",
+	}
+	if _, errors := validateS3BucketObjectMetadata(valid, "metadata"); len(errors) != 0 {
+		t.Fatalf("%q should be valid metadata: %q", valid, errors)
+	}
+
+	invalid := map[string]interface{}{
+		"Cdn-TTL": "300",
+	}
+	if _, errors := validateS3BucketObjectMetadata(invalid, "metadata"); len(errors) == 0 {
+		t.Fatalf("%q should be invalid metadata", invalid)
+	}
+}
+
 func TestValidateIntegerInRange(t *testing.T) {
 	validIntegers := []int{-259, 0, 1, 5, 999}
 	min := -259